@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+)
+
+// runQuestionsCommand handles `questions import|export ...` and returns the process exit code
+func runQuestionsCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: questions import [-dry-run] [-prune] <file> | questions export [-department slug] [-format yaml|json] [-o file]")
+		return 2
+	}
+
+	if err := utils.LoadEnvironment(); err != nil {
+		log.Fatalf("Failed to load environment: %v", err)
+	}
+	logger, err := utils.NewDevelopmentLogger()
+	if err != nil {
+		log.Fatalf("Failed to initialize logger: %v", err)
+	}
+	defer logger.Sync()
+
+	if err := services.InitDB(logger); err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	defer services.CloseDB(logger)
+
+	switch args[0] {
+	case "import":
+		return importQuestionBank(args[1:])
+	case "export":
+		return exportQuestionBank(args[1:])
+	}
+	fmt.Fprintf(os.Stderr, "unknown questions command %q\n", args[0])
+	return 2
+}
+
+// importQuestionBank applies a bank file, printing the planned changes and any issues as file:line:col
+func importQuestionBank(args []string) int {
+	fs := flag.NewFlagSet("questions import", flag.ExitOnError)
+	dryRun := fs.Bool("dry-run", false, "Show the changes without applying them")
+	prune := fs.Bool("prune", false, "Remove live questions of the bank's departments that the bank does not list")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: questions import [-dry-run] [-prune] <file>")
+		return 2
+	}
+
+	path := fs.Arg(0)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read %s: %v\n", path, err)
+		return 1
+	}
+
+	result, err := services.ImportQuestionBank(context.Background(), data, *dryRun, *prune)
+	if err != nil {
+		var bankErr *services.QuestionBankError
+		if errors.As(err, &bankErr) {
+			for _, issue := range bankErr.Issues {
+				fmt.Fprintf(os.Stderr, "%s:%s\n", path, issue)
+			}
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "import failed: %v\n", err)
+		return 1
+	}
+
+	for _, change := range result.Changes {
+		line := fmt.Sprintf("%-9s %s: %s", change.Action, change.Department, change.Body)
+		if len(change.Fields) > 0 {
+			line += fmt.Sprintf(" %v", change.Fields)
+		}
+		fmt.Println(line)
+	}
+	fmt.Printf("%d to create, %d to update, %d unchanged, %d to remove\n",
+		result.Summary[models.QuestionBankCreate], result.Summary[models.QuestionBankUpdate],
+		result.Summary[models.QuestionBankUnchanged], result.Summary[models.QuestionBankRemove])
+	if *dryRun {
+		fmt.Println("dry run: nothing was written")
+	}
+	return 0
+}
+
+// exportQuestionBank writes the live questions to a file or stdout
+func exportQuestionBank(args []string) int {
+	fs := flag.NewFlagSet("questions export", flag.ExitOnError)
+	department := fs.String("department", "", "Only export this department")
+	format := fs.String("format", "yaml", "Output format: yaml or json")
+	output := fs.String("o", "", "Output file (default stdout)")
+	fs.Parse(args)
+
+	bank, err := services.ExportQuestionBank(context.Background(), *department)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export failed: %v\n", err)
+		return 1
+	}
+	data, err := services.EncodeQuestionBank(bank, *format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export failed: %v\n", err)
+		return 1
+	}
+
+	if *output == "" {
+		os.Stdout.Write(data)
+		return 0
+	}
+	if err := os.WriteFile(*output, data, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write %s: %v\n", *output, err)
+		return 1
+	}
+	return 0
+}
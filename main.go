@@ -7,6 +7,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -24,6 +25,11 @@ import (
 )
 
 func main() {
+	// Subcommands run against the database and exit without starting the server
+	if len(os.Args) > 1 && os.Args[1] == "questions" {
+		os.Exit(runQuestionsCommand(os.Args[2:]))
+	}
+
 	// Parse command line flags
 	healthCheck := flag.Bool("health-check", false, "Perform health check and exit")
 	flag.Parse()
@@ -97,6 +103,21 @@ func main() {
 	}()
 	defer services.CloseMailer(logger)
 
+	// Initialize webhook dispatcher for outbound domain events
+	services.InitWebhooks(logger)
+
+	// Initialize in-app notification center
+	services.InitNotifications(logger)
+
+	// Initialize realtime event stream (Postgres LISTEN/NOTIFY fan-out)
+	services.InitRealtime(logger)
+
+	// Initialize background answer similarity scan
+	services.InitSimilarity(logger)
+
+	// Initialize background results announcement
+	services.InitResults(logger)
+
 	router := gin.New()
 
 	router.Use(ginzap.GinzapWithConfig(logger, &ginzap.Config{
@@ -165,8 +186,8 @@ func main() {
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     utils.GetEnvAsSlice("CORS_ALLOWED_ORIGINS", ",", []string{"*"}),
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Request-ID"},
-		ExposeHeaders:    []string{"X-Request-ID"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Request-ID", "If-Match"},
+		ExposeHeaders:    []string{"X-Request-ID", "ETag"},
 		AllowCredentials: true,
 	}))
 
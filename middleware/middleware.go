@@ -76,6 +76,19 @@ func JWTAuthMiddleware() gin.HandlerFunc {
 	}
 }
 
+// QueryTokenMiddleware accepts the JWT from the "token" query parameter when no
+// Authorization header is sent. Browser EventSource clients cannot set headers.
+func QueryTokenMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			if token := c.Query("token"); token != "" {
+				c.Request.Header.Set("Authorization", "Bearer "+token)
+			}
+		}
+		c.Next()
+	}
+}
+
 // RoleBasedAuthMiddleware checks if the user has the required role(s)
 func RoleBasedAuthMiddleware(allowedRoles ...models.UserRole) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Allocation outcomes for selected applications
+const (
+	AllocationKept       = "kept"
+	AllocationReleased   = "released"
+	AllocationWaitlisted = "waitlisted"
+)
+
+// AllocationRequest configures an allocation run
+type AllocationRequest struct {
+	Capacities map[string]int `json:"capacities"` // Overrides the stored department capacities; departments not listed are unlimited
+	DryRun     bool           `json:"dry_run"`
+}
+
+// AllocationCandidate is a selected application taking part in allocation
+type AllocationCandidate struct {
+	ApplicationID uuid.UUID
+	UserID        uuid.UUID
+	Department    string
+	Preference    *int
+	Score         float64
+	SubmittedAt   *time.Time
+}
+
+// AllocationResult is the outcome of allocation for one selected application
+type AllocationResult struct {
+	ApplicationID uuid.UUID `json:"application_id"`
+	UserID        uuid.UUID `json:"user_id"`
+	Department    string    `json:"department"`
+	Preference    *int      `json:"preference"`
+	Score         float64   `json:"score"`
+	Outcome       string    `json:"outcome"`
+}
+
+// AllocationSummary counts allocation outcomes for a department
+type AllocationSummary struct {
+	Department string `json:"department"`
+	Capacity   *int   `json:"capacity"`
+	Kept       int    `json:"kept"`
+	Released   int    `json:"released"`
+	Waitlisted int    `json:"waitlisted"`
+}
@@ -7,12 +7,23 @@ import (
 )
 
 type Answer struct {
-	ID            uuid.UUID `json:"id"`
-	ApplicationID uuid.UUID `json:"application_id"`
-	QuestionID    uuid.UUID `json:"question_id"`
-	Body          string    `json:"body"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID              uuid.UUID `json:"id"`
+	ApplicationID   uuid.UUID `json:"application_id"`
+	QuestionID      uuid.UUID `json:"question_id"`
+	Body            string    `json:"body"`
+	Version         int       `json:"version"`
+	QuestionVersion int       `json:"question_version"` // Version of the question wording the answer was written against
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// AnswerRevision is a saved version of an answer's text
+type AnswerRevision struct {
+	ID        uuid.UUID `json:"id"`
+	AnswerID  uuid.UUID `json:"answer_id"`
+	Version   int       `json:"version"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type SaveApplicationRequest struct {
@@ -22,6 +33,7 @@ type SaveApplicationRequest struct {
 type AnswerRequest struct {
 	QuestionID uuid.UUID `json:"question_id" binding:"required"`
 	Body       string    `json:"body" binding:"required"`
+	Version    *int      `json:"version,omitempty"` // Version the client last loaded; stale versions are rejected
 }
 
 type PostAnswerRequest struct {
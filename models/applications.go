@@ -6,17 +6,101 @@ import (
 	"github.com/google/uuid"
 )
 
+// Application statuses stored in applications.status
+const (
+	ApplicationStatusDraft      = "draft"
+	ApplicationStatusSubmitted  = "submitted"
+	ApplicationStatusWithdrawn  = "withdrawn"
+	ApplicationStatusSelected   = "selected"
+	ApplicationStatusRejected   = "rejected"
+	ApplicationStatusWaitlisted = "waitlisted"
+	ApplicationStatusReleased   = "released" // Selected, but allocated to a preferred department or the offer was declined or lapsed
+)
+
+// ApplicationStatuses lists every valid application status
+var ApplicationStatuses = []string{
+	ApplicationStatusDraft, ApplicationStatusSubmitted, ApplicationStatusWithdrawn, ApplicationStatusSelected,
+	ApplicationStatusRejected, ApplicationStatusWaitlisted, ApplicationStatusReleased,
+}
+
+// ApplicationDecisionStatuses are the statuses admins can set on a submitted application
+var ApplicationDecisionStatuses = []string{
+	ApplicationStatusSubmitted, ApplicationStatusSelected, ApplicationStatusRejected, ApplicationStatusWaitlisted,
+}
+
 // Application struct maps to your actual database columns
 type Application struct {
 	ID         uuid.UUID `json:"id"`
 	UserID     uuid.UUID `json:"user_id"`
 	Department string    `json:"department"`
 	Submitted  bool      `json:"submitted"`
+	Status     string    `json:"status"`
+	Preference *int      `json:"preference"`        // Applicant's ranking of this application (1 = first choice)
+	Domains    []string  `json:"domains,omitempty"` // Sub-domains picked within the department
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+
+	Rounds []ApplicationRound `json:"rounds,omitempty"` // Rounds the application has been admitted to, in order
+}
+
+// Reviewable reports whether the application has been submitted and not withdrawn
+func (a *Application) Reviewable() bool {
+	return a.Status != ApplicationStatusDraft && a.Status != ApplicationStatusWithdrawn
 }
 
 // CreateApplicationRequest is what we receive from client
 type CreateApplicationRequest struct {
-	Department string `json:"department" binding:"required"`
+	Department string   `json:"department" binding:"required"`
+	Domains    []string `json:"domains"` // Optional sub-domain slugs within the department
+}
+
+// WithdrawApplicationRequest is the optional body of a withdrawal
+type WithdrawApplicationRequest struct {
+	Reason string `json:"reason" binding:"max=1000"`
+}
+
+// ApplicationWithdrawal records a withdrawal and, if it happened, its reinstatement
+type ApplicationWithdrawal struct {
+	ID                uuid.UUID  `json:"id"`
+	ApplicationID     uuid.UUID  `json:"application_id"`
+	UserID            uuid.UUID  `json:"user_id"`
+	Reason            string     `json:"reason"`
+	PreviousStatus    string     `json:"previous_status"`
+	WithdrawnAt       time.Time  `json:"withdrawn_at"`
+	ReinstatableUntil time.Time  `json:"reinstatable_until"`
+	ReinstatedAt      *time.Time `json:"reinstated_at"`
+}
+
+// WithdrawalReport summarises withdrawals for admins
+type WithdrawalReport struct {
+	Total       int                    `json:"total"`
+	Reinstated  int                    `json:"reinstated"`
+	Departments []WithdrawalCount      `json:"departments"`
+	Reasons     []WithdrawalCount      `json:"reasons"`
+	Recent      []WithdrawalReportItem `json:"recent"`
+	GeneratedAt time.Time              `json:"generated_at"`
+}
+
+// WithdrawalCount is the number of withdrawals for a department or reason
+type WithdrawalCount struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+}
+
+// WithdrawalReportItem is a single recent withdrawal with its applicant
+type WithdrawalReportItem struct {
+	ApplicationWithdrawal
+	Department string `json:"department"`
+	FullName   string `json:"full_name"`
+	RegNum     string `json:"reg_num"`
+}
+
+// SetApplicationPreferencesRequest ranks the applicant's applications, first choice first
+type SetApplicationPreferencesRequest struct {
+	ApplicationIDs []uuid.UUID `json:"application_ids" binding:"required"`
+}
+
+// SetApplicationDecisionRequest represents an admin decision on an application
+type SetApplicationDecisionRequest struct {
+	Status string `json:"status" binding:"required"`
 }
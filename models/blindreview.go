@@ -0,0 +1,33 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplicantCode is the pseudonym reviewers see in place of an applicant's identity under blind review.
+// It is derived from the application ID, so it stays the same across endpoints and requests.
+func ApplicantCode(applicationID uuid.UUID) string {
+	sum := sha256.Sum256(applicationID[:])
+	return "A-" + strings.ToUpper(hex.EncodeToString(sum[:4]))
+}
+
+// ApplicantReveal is an audit record of an admin de-anonymising a blind-reviewed applicant
+type ApplicantReveal struct {
+	ID             uuid.UUID  `json:"id"`
+	ApplicationID  uuid.UUID  `json:"application_id"`
+	ApplicantCode  string     `json:"applicant_code"`
+	RevealedBy     *uuid.UUID `json:"revealed_by"`
+	RevealedByName string     `json:"revealed_by_name"`
+	Reason         string     `json:"reason"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// RevealApplicantRequest represents the request body for de-anonymising a blind-reviewed applicant
+type RevealApplicantRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000"`
+}
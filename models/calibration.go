@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultOutlierThreshold is how far, in standard deviations, an evaluation may sit from the other evaluators' consensus
+const DefaultOutlierThreshold = 1.5
+
+// CalibrationScore is a single evaluation fed into score calibration
+type CalibrationScore struct {
+	EvaluationID  uuid.UUID
+	ApplicationID uuid.UUID
+	Department    string
+	EvaluatorID   uuid.UUID
+	EvaluatorName string
+	Score         int
+}
+
+// CalibrationReport compares how evaluators score and puts application scores on a common scale
+type CalibrationReport struct {
+	Department       string                       `json:"department,omitempty"`
+	OutlierThreshold float64                      `json:"outlier_threshold"`
+	Evaluators       []EvaluatorCalibration       `json:"evaluators"`
+	Applications     []NormalisedApplicationScore `json:"applications"`
+	Agreement        InterRaterAgreement          `json:"agreement"`
+	Outliers         []OutlierEvaluation          `json:"outliers"`
+	GeneratedAt      time.Time                    `json:"generated_at"`
+}
+
+// EvaluatorCalibration is an evaluator's scoring tendency
+type EvaluatorCalibration struct {
+	EvaluatorID   uuid.UUID `json:"evaluator_id"`
+	EvaluatorName string    `json:"evaluator_name"`
+	Evaluations   int       `json:"evaluations"`
+	Mean          float64   `json:"mean"`
+	StdDev        float64   `json:"stddev"` // Sample standard deviation; 0 with fewer than two evaluations
+}
+
+// NormalisedApplicationScore is an application's raw mean score next to the mean of its evaluators' z-scores
+type NormalisedApplicationScore struct {
+	ApplicationID   uuid.UUID `json:"application_id"`
+	Department      string    `json:"department"`
+	Evaluations     int       `json:"evaluations"`
+	RawMean         float64   `json:"raw_mean"`
+	NormalisedScore float64   `json:"normalised_score"`
+}
+
+// InterRaterAgreement is Krippendorff's alpha (interval metric) over applications scored by more than one evaluator.
+// Alpha is 1 for perfect agreement, around 0 for chance-level agreement and nil when it cannot be computed.
+type InterRaterAgreement struct {
+	Method       string   `json:"method"`
+	Alpha        *float64 `json:"alpha"`
+	Applications int      `json:"applications"`
+	Evaluations  int      `json:"evaluations"`
+}
+
+// OutlierEvaluation is an evaluation far from what the application's other evaluators gave, once normalised
+type OutlierEvaluation struct {
+	EvaluationID  uuid.UUID `json:"evaluation_id"`
+	ApplicationID uuid.UUID `json:"application_id"`
+	EvaluatorID   uuid.UUID `json:"evaluator_id"`
+	EvaluatorName string    `json:"evaluator_name"`
+	Score         int       `json:"score"`
+	ZScore        float64   `json:"z_score"`
+	OthersMeanZ   float64   `json:"others_mean_z"`
+	Deviation     float64   `json:"deviation"` // ZScore minus OthersMeanZ
+}
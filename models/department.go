@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Department is a team applicants can apply to, identified by its slug
+type Department struct {
+	Slug        string             `json:"slug" db:"slug"`
+	Name        string             `json:"name" db:"name"`
+	Description string             `json:"description" db:"description"`
+	Active      bool               `json:"active" db:"active"`
+	Capacity    *int               `json:"capacity" db:"capacity"`         // Seats available; nil means unlimited
+	BlindReview bool               `json:"blind_review" db:"blind_review"` // Hide applicant identity from reviewers in the first round
+	LeadIDs     []uuid.UUID        `json:"lead_ids,omitempty"`
+	Domains     []DepartmentDomain `json:"domains"`
+	CreatedAt   time.Time          `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at" db:"updated_at"`
+}
+
+// CreateDepartmentRequest represents the request body for creating a department
+type CreateDepartmentRequest struct {
+	Slug        string      `json:"slug" binding:"required,max=50"`
+	Name        string      `json:"name" binding:"required,max=100"`
+	Description string      `json:"description"`
+	Active      *bool       `json:"active"` // Defaults to true
+	Capacity    *int        `json:"capacity" binding:"omitempty,min=0"`
+	BlindReview bool        `json:"blind_review"`
+	LeadIDs     []uuid.UUID `json:"lead_ids"`
+}
+
+// UpdateDepartmentRequest represents the request body for replacing a department's settings; the slug cannot change
+type UpdateDepartmentRequest struct {
+	Name        string      `json:"name" binding:"required,max=100"`
+	Description string      `json:"description"`
+	Active      bool        `json:"active"`
+	Capacity    *int        `json:"capacity" binding:"omitempty,min=0"`
+	BlindReview bool        `json:"blind_review"`
+	LeadIDs     []uuid.UUID `json:"lead_ids"`
+}
+
+// DepartmentDomain is an optional sub-domain within a department, such as web or ML under technical
+type DepartmentDomain struct {
+	Department  string    `json:"department" db:"department"`
+	Slug        string    `json:"slug" db:"slug"`
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateDepartmentDomainRequest represents the request body for adding a sub-domain to a department
+type CreateDepartmentDomainRequest struct {
+	Slug        string `json:"slug" binding:"required,max=50"`
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description"`
+}
+
+// UpdateDepartmentDomainRequest represents the request body for renaming a sub-domain; the slug cannot change
+type UpdateDepartmentDomainRequest struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description"`
+}
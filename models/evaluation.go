@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Evaluation is an evaluator's score and notes for a submitted application
+type Evaluation struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	ApplicationID uuid.UUID `json:"application_id" db:"application_id"`
+	EvaluatorID   uuid.UUID `json:"evaluator_id" db:"evaluator_id"`
+	Score         int       `json:"score" db:"score"`
+	Notes         string    `json:"notes" db:"notes"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// RecordEvaluationRequest represents the request body for recording an evaluation
+type RecordEvaluationRequest struct {
+	Score *int   `json:"score" binding:"required,min=0,max=10"`
+	Notes string `json:"notes"`
+}
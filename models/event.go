@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType represents the kind of domain event raised by the system
+type EventType string
+
+const (
+	EventUserRegistered           EventType = "user.registered"
+	EventUserChickenedOut         EventType = "user.chickened_out"
+	EventApplicationCreated       EventType = "application.created"
+	EventApplicationSubmitted     EventType = "application.submitted"
+	EventApplicationStatusChanged EventType = "application.status_changed"
+	EventApplicationAdvanced      EventType = "application.advanced"
+	EventEvaluationRecorded       EventType = "evaluation.recorded"
+	EventResultsPublished         EventType = "results.published"
+	EventOfferAccepted            EventType = "offer.accepted"
+	EventOfferDeclined            EventType = "offer.declined"
+	EventWaitlistPromoted         EventType = "waitlist.promoted"
+	EventWebhookTest              EventType = "webhook.test"
+)
+
+// SubscribableEventTypes lists the event types webhooks can subscribe to
+var SubscribableEventTypes = []EventType{
+	EventUserRegistered,
+	EventUserChickenedOut,
+	EventApplicationCreated,
+	EventApplicationSubmitted,
+	EventApplicationStatusChanged,
+	EventApplicationAdvanced,
+	EventEvaluationRecorded,
+	EventResultsPublished,
+	EventOfferAccepted,
+	EventOfferDeclined,
+	EventWaitlistPromoted,
+}
+
+// Event is a domain event published when something notable happens
+type Event struct {
+	ID         uuid.UUID `json:"id"`
+	Type       EventType `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// ApplicationStatusChange is the payload of an application.status_changed event
+type ApplicationStatusChange struct {
+	Application    Application `json:"application"`
+	PreviousStatus string      `json:"previous_status"`
+	Status         string      `json:"status"`
+}
@@ -0,0 +1,15 @@
+-- Rollback migration: 000004_add_webhooks
+-- This script removes the webhook subscriptions and delivery log
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_webhook_deliveries_next_attempt_at;
+DROP INDEX IF EXISTS idx_webhook_deliveries_event_id;
+DROP INDEX IF EXISTS idx_webhook_deliveries_webhook_id;
+DROP INDEX IF EXISTS idx_webhooks_active;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_webhooks_updated_at ON webhooks;
+
+-- Drop tables
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,9 @@
+-- Rollback migration: 000005_add_notifications
+-- This script removes the in-app notification center
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_notifications_user_unread;
+DROP INDEX IF EXISTS idx_notifications_user_id;
+
+-- Drop table
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,12 @@
+-- Rollback migration: 000006_add_evaluations
+-- This script removes evaluator scores and notes
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_evaluations_evaluator_id;
+DROP INDEX IF EXISTS idx_evaluations_application_id;
+
+-- Drop trigger
+DROP TRIGGER IF EXISTS update_evaluations_updated_at ON evaluations;
+
+-- Drop table
+DROP TABLE IF EXISTS evaluations;
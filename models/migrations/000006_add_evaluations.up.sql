@@ -0,0 +1,31 @@
+-- Migration: 000006_add_evaluations
+-- This script adds evaluator scores and notes for submitted applications
+
+-- Create evaluations table
+CREATE TABLE IF NOT EXISTS evaluations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL,
+    evaluator_id UUID NOT NULL,
+    score INTEGER NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_evaluations_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_evaluations_evaluator_id FOREIGN KEY (evaluator_id) REFERENCES users(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT evaluations_application_evaluator_unique UNIQUE (application_id, evaluator_id),
+    CONSTRAINT evaluations_score_range CHECK (score BETWEEN 0 AND 10)
+);
+
+-- Create trigger for evaluations table
+CREATE TRIGGER update_evaluations_updated_at
+    BEFORE UPDATE ON evaluations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_evaluations_application_id ON evaluations (application_id);
+CREATE INDEX IF NOT EXISTS idx_evaluations_evaluator_id ON evaluations (evaluator_id);
@@ -0,0 +1,11 @@
+-- Rollback migration: 000007_add_application_submitted_at
+-- This script removes the submission timestamp and reporting indexes
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_evaluations_created_at;
+DROP INDEX IF EXISTS idx_applications_submitted_at;
+DROP INDEX IF EXISTS idx_applications_created_at;
+DROP INDEX IF EXISTS idx_users_created_at;
+
+-- Drop column
+ALTER TABLE applications DROP COLUMN IF EXISTS submitted_at;
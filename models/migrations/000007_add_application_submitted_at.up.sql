@@ -0,0 +1,14 @@
+-- Migration: 000007_add_application_submitted_at
+-- This script records when applications are submitted and indexes creation times for reporting
+
+-- Add submitted_at column
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS submitted_at TIMESTAMP WITH TIME ZONE;
+
+-- Backfill already submitted applications with their last update time
+UPDATE applications SET submitted_at = updated_at WHERE submitted = true AND submitted_at IS NULL;
+
+-- Create indexes for time series reporting
+CREATE INDEX IF NOT EXISTS idx_users_created_at ON users (created_at);
+CREATE INDEX IF NOT EXISTS idx_applications_created_at ON applications (created_at);
+CREATE INDEX IF NOT EXISTS idx_applications_submitted_at ON applications (submitted_at);
+CREATE INDEX IF NOT EXISTS idx_evaluations_created_at ON evaluations (created_at);
@@ -0,0 +1,11 @@
+-- Rollback migration: 000008_add_answer_search
+-- This script removes full-text search over answers and per-evaluator department access
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_answers_body_tsv;
+
+-- Drop table
+DROP TABLE IF EXISTS evaluator_departments;
+
+-- Drop column
+ALTER TABLE answers DROP COLUMN IF EXISTS body_tsv;
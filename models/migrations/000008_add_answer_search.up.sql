@@ -0,0 +1,22 @@
+-- Migration: 000008_add_answer_search
+-- This script adds full-text search over answers and per-evaluator department access
+
+-- Add generated search vector to answers
+ALTER TABLE answers
+    ADD COLUMN IF NOT EXISTS body_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('english', body)) STORED;
+
+-- Create evaluator_departments table (evaluators with no rows can access every department)
+CREATE TABLE IF NOT EXISTS evaluator_departments (
+    user_id UUID NOT NULL,
+    department department NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_evaluator_departments_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT evaluator_departments_pkey PRIMARY KEY (user_id, department)
+);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_answers_body_tsv ON answers USING GIN (body_tsv);
@@ -0,0 +1,10 @@
+-- Rollback migration: 000009_add_similarity_flags
+-- This script removes stored answer similarity pairs
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_similarity_flags_similarity;
+DROP INDEX IF EXISTS idx_similarity_flags_answer_b_id;
+DROP INDEX IF EXISTS idx_similarity_flags_question_id;
+
+-- Drop table
+DROP TABLE IF EXISTS similarity_flags;
@@ -0,0 +1,28 @@
+-- Migration: 000009_add_similarity_flags
+-- This script stores pairs of near-identical answers to the same question
+
+-- Create similarity_flags table (answer_a_id is always the smaller ID so each pair is stored once)
+CREATE TABLE IF NOT EXISTS similarity_flags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    question_id UUID NOT NULL,
+    answer_a_id UUID NOT NULL,
+    answer_b_id UUID NOT NULL,
+    similarity REAL NOT NULL,
+    checked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_similarity_flags_question_id FOREIGN KEY (question_id) REFERENCES questions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_similarity_flags_answer_a_id FOREIGN KEY (answer_a_id) REFERENCES answers(id) ON DELETE CASCADE,
+    CONSTRAINT fk_similarity_flags_answer_b_id FOREIGN KEY (answer_b_id) REFERENCES answers(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT similarity_flags_pair_unique UNIQUE (answer_a_id, answer_b_id),
+    CONSTRAINT similarity_flags_pair_ordered CHECK (answer_a_id < answer_b_id),
+    CONSTRAINT similarity_flags_similarity_range CHECK (similarity BETWEEN 0 AND 1)
+);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_similarity_flags_question_id ON similarity_flags (question_id);
+CREATE INDEX IF NOT EXISTS idx_similarity_flags_answer_b_id ON similarity_flags (answer_b_id);
+CREATE INDEX IF NOT EXISTS idx_similarity_flags_similarity ON similarity_flags (similarity DESC);
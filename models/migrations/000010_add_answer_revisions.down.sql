@@ -0,0 +1,8 @@
+-- Rollback migration: 000010_add_answer_revisions
+-- This script removes answer versioning and revision history
+
+-- Drop table
+DROP TABLE IF EXISTS answer_revisions;
+
+-- Drop column
+ALTER TABLE answers DROP COLUMN IF EXISTS version;
@@ -0,0 +1,25 @@
+-- Migration: 000010_add_answer_revisions
+-- This script versions answers and keeps every saved revision
+
+-- Add version column to answers
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+-- Create answer_revisions table
+CREATE TABLE IF NOT EXISTS answer_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    answer_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_answer_revisions_answer_id FOREIGN KEY (answer_id) REFERENCES answers(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT answer_revisions_answer_version_unique UNIQUE (answer_id, version)
+);
+
+-- Record the current text of existing answers as their first revision
+INSERT INTO answer_revisions (answer_id, version, body, created_at)
+SELECT id, version, body, updated_at FROM answers
+ON CONFLICT DO NOTHING;
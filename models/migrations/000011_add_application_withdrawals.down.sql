@@ -0,0 +1,14 @@
+-- Rollback migration: 000011_add_application_withdrawals
+-- This script removes application status and withdrawals
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_application_withdrawals_open;
+DROP INDEX IF EXISTS idx_application_withdrawals_withdrawn_at;
+DROP INDEX IF EXISTS idx_applications_status;
+
+-- Drop table
+DROP TABLE IF EXISTS application_withdrawals;
+
+-- Drop column
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_status_valid;
+ALTER TABLE applications DROP COLUMN IF EXISTS status;
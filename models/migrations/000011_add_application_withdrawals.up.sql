@@ -0,0 +1,35 @@
+-- Migration: 000011_add_application_withdrawals
+-- This script adds an explicit application status and per-application withdrawal with reinstatement
+
+-- Add status column to applications
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'draft';
+
+-- Backfill status from the submitted flag
+UPDATE applications SET status = 'submitted' WHERE submitted = true;
+
+-- Restrict status values
+ALTER TABLE applications ADD CONSTRAINT applications_status_valid CHECK (status IN ('draft', 'submitted', 'withdrawn'));
+
+-- Create application_withdrawals table (one row per withdrawal, closed when reinstated)
+CREATE TABLE IF NOT EXISTS application_withdrawals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    previous_status VARCHAR(20) NOT NULL,
+    withdrawn_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    reinstatable_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    reinstated_at TIMESTAMP WITH TIME ZONE,
+
+    -- Foreign keys
+    CONSTRAINT fk_application_withdrawals_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_application_withdrawals_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT application_withdrawals_reason_length CHECK (LENGTH(reason) <= 1000)
+);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_applications_status ON applications (status);
+CREATE INDEX IF NOT EXISTS idx_application_withdrawals_withdrawn_at ON application_withdrawals (withdrawn_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_application_withdrawals_open ON application_withdrawals (application_id) WHERE reinstated_at IS NULL;
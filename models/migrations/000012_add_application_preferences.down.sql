@@ -0,0 +1,16 @@
+-- Rollback migration: 000012_add_application_preferences
+-- This script removes application preferences and selection outcomes
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_applications_user_preference;
+
+-- Return post-submission outcomes to submitted
+UPDATE applications SET status = 'submitted' WHERE status IN ('selected', 'rejected', 'waitlisted', 'released');
+
+-- Restore status values
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_status_valid;
+ALTER TABLE applications ADD CONSTRAINT applications_status_valid CHECK (status IN ('draft', 'submitted', 'withdrawn'));
+
+-- Drop column
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_preference_positive;
+ALTER TABLE applications DROP COLUMN IF EXISTS preference;
@@ -0,0 +1,14 @@
+-- Migration: 000012_add_application_preferences
+-- This script lets applicants rank their applications and adds selection outcomes used by allocation
+
+-- Add preference column to applications (1 = first choice)
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS preference SMALLINT;
+ALTER TABLE applications ADD CONSTRAINT applications_preference_positive CHECK (preference IS NULL OR preference >= 1);
+
+-- Allow selection outcomes in application status
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS applications_status_valid;
+ALTER TABLE applications ADD CONSTRAINT applications_status_valid
+    CHECK (status IN ('draft', 'submitted', 'withdrawn', 'selected', 'rejected', 'waitlisted', 'released'));
+
+-- Create indexes for better performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_applications_user_preference ON applications (user_id, preference) WHERE preference IS NOT NULL;
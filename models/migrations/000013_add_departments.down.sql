@@ -0,0 +1,19 @@
+-- Rollback migration: 000013_add_departments
+-- This script restores the department enum; rows referencing departments outside the enum must be removed first
+
+-- Recreate the enum type
+CREATE TYPE department AS ENUM ('technical', 'management', 'social_media', 'design');
+
+-- Drop foreign keys to the departments table
+ALTER TABLE evaluator_departments DROP CONSTRAINT IF EXISTS fk_evaluator_departments_department;
+ALTER TABLE applications DROP CONSTRAINT IF EXISTS fk_applications_department;
+ALTER TABLE questions DROP CONSTRAINT IF EXISTS fk_questions_department;
+
+-- Convert department columns back to the enum
+ALTER TABLE evaluator_departments ALTER COLUMN department TYPE department USING department::department;
+ALTER TABLE applications ALTER COLUMN department TYPE department USING department::department;
+ALTER TABLE questions ALTER COLUMN department TYPE department USING department::department;
+
+-- Drop tables
+DROP TABLE IF EXISTS department_leads;
+DROP TABLE IF EXISTS departments;
@@ -0,0 +1,56 @@
+-- Migration: 000013_add_departments
+-- This script replaces the department enum with a managed departments table
+
+-- Create departments table (slug is the stable identifier stored on questions and applications)
+CREATE TABLE IF NOT EXISTS departments (
+    slug VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    capacity INTEGER,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Constraints
+    CONSTRAINT departments_slug_format CHECK (slug ~ '^[a-z][a-z0-9_]*$'),
+    CONSTRAINT departments_name_not_empty CHECK (LENGTH(TRIM(name)) > 0),
+    CONSTRAINT departments_capacity_non_negative CHECK (capacity IS NULL OR capacity >= 0)
+);
+
+-- Create department_leads table (admins responsible for a department)
+CREATE TABLE IF NOT EXISTS department_leads (
+    department VARCHAR(50) NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_department_leads_department FOREIGN KEY (department) REFERENCES departments(slug) ON DELETE CASCADE,
+    CONSTRAINT fk_department_leads_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT department_leads_pkey PRIMARY KEY (department, user_id)
+);
+
+-- Seed the departments that previously made up the enum
+INSERT INTO departments (slug, name) VALUES
+    ('technical', 'Technical'),
+    ('management', 'Management'),
+    ('social_media', 'Social Media'),
+    ('design', 'Design')
+ON CONFLICT (slug) DO NOTHING;
+
+-- Convert department columns from the enum to plain text
+ALTER TABLE questions ALTER COLUMN department TYPE VARCHAR(50) USING department::text;
+ALTER TABLE applications ALTER COLUMN department TYPE VARCHAR(50) USING department::text;
+ALTER TABLE evaluator_departments ALTER COLUMN department TYPE VARCHAR(50) USING department::text;
+
+-- Reference the departments table instead
+ALTER TABLE questions ADD CONSTRAINT fk_questions_department FOREIGN KEY (department) REFERENCES departments(slug);
+ALTER TABLE applications ADD CONSTRAINT fk_applications_department FOREIGN KEY (department) REFERENCES departments(slug);
+ALTER TABLE evaluator_departments ADD CONSTRAINT fk_evaluator_departments_department FOREIGN KEY (department) REFERENCES departments(slug) ON DELETE CASCADE;
+
+-- Drop the enum type
+DROP TYPE department;
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_department_leads_user_id ON department_leads (user_id);
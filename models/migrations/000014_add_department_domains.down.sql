@@ -0,0 +1,12 @@
+-- Rollback migration: 000014_add_department_domains
+-- This script removes department sub-domains
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_application_domains_domain;
+DROP INDEX IF EXISTS idx_questions_department_domain;
+
+-- Drop tables and columns
+DROP TABLE IF EXISTS application_domains;
+ALTER TABLE questions DROP CONSTRAINT IF EXISTS fk_questions_domain;
+ALTER TABLE questions DROP COLUMN IF EXISTS domain;
+DROP TABLE IF EXISTS department_domains;
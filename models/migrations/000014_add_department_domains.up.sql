@@ -0,0 +1,43 @@
+-- Migration: 000014_add_department_domains
+-- This script adds optional sub-domains within departments, targeted by questions and chosen by applications
+
+-- Create department_domains table (slugs are unique within a department)
+CREATE TABLE IF NOT EXISTS department_domains (
+    department VARCHAR(50) NOT NULL,
+    slug VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_department_domains_department FOREIGN KEY (department) REFERENCES departments(slug) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT department_domains_pkey PRIMARY KEY (department, slug),
+    CONSTRAINT department_domains_slug_format CHECK (slug ~ '^[a-z][a-z0-9_]*$'),
+    CONSTRAINT department_domains_name_not_empty CHECK (LENGTH(TRIM(name)) > 0)
+);
+
+-- Questions target either the whole department (NULL) or one of its domains
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS domain VARCHAR(50);
+ALTER TABLE questions ADD CONSTRAINT fk_questions_domain FOREIGN KEY (department, domain) REFERENCES department_domains(department, slug);
+
+-- Create application_domains table (the domains an applicant picked within the application's department)
+CREATE TABLE IF NOT EXISTS application_domains (
+    application_id UUID NOT NULL,
+    department VARCHAR(50) NOT NULL,
+    domain VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_application_domains_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_application_domains_domain FOREIGN KEY (department, domain) REFERENCES department_domains(department, slug) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT application_domains_pkey PRIMARY KEY (application_id, domain)
+);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_questions_department_domain ON questions (department, domain);
+CREATE INDEX IF NOT EXISTS idx_application_domains_domain ON application_domains (department, domain);
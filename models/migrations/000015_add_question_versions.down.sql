@@ -0,0 +1,16 @@
+-- Rollback migration: 000015_add_question_versions
+-- This script removes question ordering, archiving and versioning
+
+-- Drop indexes
+DROP INDEX IF EXISTS idx_questions_department_position;
+
+-- Drop answer links and versions
+ALTER TABLE answers DROP CONSTRAINT IF EXISTS fk_answers_question_version;
+ALTER TABLE answers DROP COLUMN IF EXISTS question_version;
+DROP TABLE IF EXISTS question_versions;
+
+-- Drop question columns
+ALTER TABLE questions DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE questions DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE questions DROP COLUMN IF EXISTS version;
+ALTER TABLE questions DROP COLUMN IF EXISTS position;
@@ -0,0 +1,44 @@
+-- Migration: 000015_add_question_versions
+-- This script adds question ordering, soft archiving and versioned wording linked from answers
+
+-- Add ordering, archiving and versioning columns to questions
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS position INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+UPDATE questions SET updated_at = created_at;
+
+-- Backfill positions from creation order within each department
+UPDATE questions q
+SET position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY department ORDER BY created_at, id) AS position
+    FROM questions
+) ordered
+WHERE q.id = ordered.id;
+
+-- Create question_versions table (one row per wording a question has had)
+CREATE TABLE IF NOT EXISTS question_versions (
+    question_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_question_versions_question_id FOREIGN KEY (question_id) REFERENCES questions(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT question_versions_pkey PRIMARY KEY (question_id, version)
+);
+
+-- Record the current wording of existing questions as their first version
+INSERT INTO question_versions (question_id, version, body, created_at)
+SELECT id, version, body, created_at FROM questions
+ON CONFLICT (question_id, version) DO NOTHING;
+
+-- Link answers to the wording they were written against
+ALTER TABLE answers ADD COLUMN IF NOT EXISTS question_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE answers ADD CONSTRAINT fk_answers_question_version FOREIGN KEY (question_id, question_version) REFERENCES question_versions(question_id, version);
+
+-- Create indexes for better performance
+CREATE INDEX IF NOT EXISTS idx_questions_department_position ON questions (department, position);
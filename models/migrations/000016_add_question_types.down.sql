@@ -0,0 +1,7 @@
+-- Rollback migration: 000016_add_question_types
+-- This script removes question types, options and required flags
+
+ALTER TABLE questions DROP CONSTRAINT IF EXISTS questions_type_valid;
+ALTER TABLE questions DROP COLUMN IF EXISTS required;
+ALTER TABLE questions DROP COLUMN IF EXISTS options;
+ALTER TABLE questions DROP COLUMN IF EXISTS type;
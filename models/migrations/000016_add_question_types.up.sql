@@ -0,0 +1,10 @@
+-- Migration: 000016_add_question_types
+-- This script adds answer types, choice options and required flags to questions
+
+-- Add type, options and required columns to questions
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS type VARCHAR(20) NOT NULL DEFAULT 'long_text';
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS options TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS required BOOLEAN NOT NULL DEFAULT false;
+
+-- Restrict type values
+ALTER TABLE questions ADD CONSTRAINT questions_type_valid CHECK (type IN ('short_text', 'long_text', 'url', 'single_choice', 'multi_choice'));
@@ -0,0 +1,5 @@
+-- Rollback migration: 000017_add_question_conditions
+-- This script removes question visibility rules
+
+DROP INDEX IF EXISTS idx_question_conditions_depends_on;
+DROP TABLE IF EXISTS question_conditions;
@@ -0,0 +1,21 @@
+-- Migration: 000017_add_question_conditions
+-- This script adds visibility rules that show a question only when an earlier answer matches
+
+CREATE TABLE IF NOT EXISTS question_conditions (
+    question_id UUID NOT NULL,
+    depends_on UUID NOT NULL,
+    operator VARCHAR(20) NOT NULL,
+    "values" TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_question_conditions_question_id FOREIGN KEY (question_id) REFERENCES questions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_question_conditions_depends_on FOREIGN KEY (depends_on) REFERENCES questions(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT question_conditions_pkey PRIMARY KEY (question_id, depends_on),
+    CONSTRAINT question_conditions_not_self CHECK (question_id <> depends_on),
+    CONSTRAINT question_conditions_operator_valid CHECK (operator IN ('equals', 'contains', 'one_of'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_question_conditions_depends_on ON question_conditions (depends_on);
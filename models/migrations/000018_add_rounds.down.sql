@@ -0,0 +1,10 @@
+-- Rollback migration: 000018_add_rounds
+-- This script removes recruitment rounds
+
+DROP INDEX IF EXISTS idx_application_rounds_round_id;
+DROP TABLE IF EXISTS application_rounds;
+DROP INDEX IF EXISTS idx_questions_round_id;
+ALTER TABLE questions DROP CONSTRAINT IF EXISTS fk_questions_round_id;
+ALTER TABLE questions DROP COLUMN IF EXISTS round_id;
+DROP INDEX IF EXISTS idx_rounds_department_position;
+DROP TABLE IF EXISTS rounds;
@@ -0,0 +1,54 @@
+-- Migration: 000018_add_rounds
+-- This script adds recruitment rounds per department, attaches questions to a round and tracks which rounds
+-- each application has been admitted to
+
+-- Create rounds table (ordered stages of a department's recruitment, e.g. application form, task, interview)
+CREATE TABLE IF NOT EXISTS rounds (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    department VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    position INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_rounds_department FOREIGN KEY (department) REFERENCES departments(slug) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_rounds_department_position ON rounds (department, position);
+
+-- Every department starts with the application form round
+INSERT INTO rounds (department, name, position)
+SELECT slug, 'Application', 1 FROM departments;
+
+-- Attach questions to a round, defaulting existing questions to the application form
+ALTER TABLE questions ADD COLUMN IF NOT EXISTS round_id UUID;
+UPDATE questions q SET round_id = r.id FROM rounds r WHERE r.department = q.department AND r.position = 1;
+ALTER TABLE questions ALTER COLUMN round_id SET NOT NULL;
+ALTER TABLE questions ADD CONSTRAINT fk_questions_round_id FOREIGN KEY (round_id) REFERENCES rounds(id);
+CREATE INDEX IF NOT EXISTS idx_questions_round_id ON questions (round_id);
+
+-- Create application_rounds table (the rounds an application has been admitted to)
+CREATE TABLE IF NOT EXISTS application_rounds (
+    application_id UUID NOT NULL,
+    round_id UUID NOT NULL,
+    admitted_by UUID,
+    admitted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_application_rounds_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_application_rounds_round_id FOREIGN KEY (round_id) REFERENCES rounds(id),
+    CONSTRAINT fk_application_rounds_admitted_by FOREIGN KEY (admitted_by) REFERENCES users(id) ON DELETE SET NULL,
+
+    -- Constraints
+    CONSTRAINT application_rounds_pkey PRIMARY KEY (application_id, round_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_rounds_round_id ON application_rounds (round_id);
+
+-- Existing applications are in the application form round
+INSERT INTO application_rounds (application_id, round_id, admitted_at)
+SELECT a.id, r.id, a.created_at
+FROM applications a
+JOIN rounds r ON r.department = a.department AND r.position = 1;
@@ -0,0 +1,10 @@
+-- Rollback migration: 000019_add_tasks
+-- This script removes tasks, submissions, extensions and grades
+
+DROP TRIGGER IF EXISTS update_task_grades_updated_at ON task_grades;
+DROP TABLE IF EXISTS task_grades;
+DROP INDEX IF EXISTS idx_task_submissions_application_id;
+DROP TABLE IF EXISTS task_submissions;
+DROP TABLE IF EXISTS task_extensions;
+DROP INDEX IF EXISTS idx_tasks_round_id;
+DROP TABLE IF EXISTS tasks;
@@ -0,0 +1,92 @@
+-- Migration: 000019_add_tasks
+-- This script adds take-home tasks attached to a round, applicant submissions, deadline extensions and grades
+
+-- Create tasks table
+CREATE TABLE IF NOT EXISTS tasks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    round_id UUID NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '', -- Markdown
+    attachments JSONB NOT NULL DEFAULT '[]',
+    deadline TIMESTAMP WITH TIME ZONE NOT NULL,
+    late_window_minutes INTEGER NOT NULL DEFAULT 0,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_tasks_round_id FOREIGN KEY (round_id) REFERENCES rounds(id),
+    CONSTRAINT fk_tasks_created_by FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL,
+
+    -- Constraints
+    CONSTRAINT tasks_late_window_valid CHECK (late_window_minutes >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tasks_round_id ON tasks (round_id);
+
+-- Create task_extensions table (per-applicant deadline overrides)
+CREATE TABLE IF NOT EXISTS task_extensions (
+    task_id UUID NOT NULL,
+    application_id UUID NOT NULL,
+    deadline TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    granted_by UUID,
+    granted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_task_extensions_task_id FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_extensions_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_extensions_granted_by FOREIGN KEY (granted_by) REFERENCES users(id) ON DELETE SET NULL,
+
+    -- Constraints
+    CONSTRAINT task_extensions_pkey PRIMARY KEY (task_id, application_id)
+);
+
+-- Create task_submissions table (one per applicant and task; resubmitting replaces it)
+CREATE TABLE IF NOT EXISTS task_submissions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    task_id UUID NOT NULL,
+    application_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    repository_url TEXT NOT NULL,
+    deploy_url TEXT,
+    notes TEXT NOT NULL DEFAULT '',
+    late BOOLEAN NOT NULL DEFAULT false,
+    submitted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_task_submissions_task_id FOREIGN KEY (task_id) REFERENCES tasks(id),
+    CONSTRAINT fk_task_submissions_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_submissions_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT task_submissions_task_application_unique UNIQUE (task_id, application_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_submissions_application_id ON task_submissions (application_id);
+
+-- Create task_grades table (evaluator scores on the same 0-10 scale as application evaluations)
+CREATE TABLE IF NOT EXISTS task_grades (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    submission_id UUID NOT NULL,
+    evaluator_id UUID NOT NULL,
+    score INTEGER NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_task_grades_submission_id FOREIGN KEY (submission_id) REFERENCES task_submissions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_task_grades_evaluator_id FOREIGN KEY (evaluator_id) REFERENCES users(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT task_grades_submission_evaluator_unique UNIQUE (submission_id, evaluator_id),
+    CONSTRAINT task_grades_score_range CHECK (score BETWEEN 0 AND 10)
+);
+
+-- Create trigger for task_grades table
+CREATE TRIGGER update_task_grades_updated_at
+    BEFORE UPDATE ON task_grades
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
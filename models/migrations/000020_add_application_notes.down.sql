@@ -0,0 +1,7 @@
+-- Rollback migration: 000020_add_application_notes
+-- This script removes application notes and tags
+
+DROP INDEX IF EXISTS idx_application_tags_tag;
+DROP TABLE IF EXISTS application_tags;
+DROP INDEX IF EXISTS idx_application_notes_application_id;
+DROP TABLE IF EXISTS application_notes;
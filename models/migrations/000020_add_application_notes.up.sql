@@ -0,0 +1,39 @@
+-- Migration: 000020_add_application_notes
+-- This script adds evaluator notes with visibility levels and free-form tags on applications
+
+-- Create application_notes table
+CREATE TABLE IF NOT EXISTS application_notes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    body TEXT NOT NULL,
+    visibility VARCHAR(20) NOT NULL DEFAULT 'private',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_application_notes_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_application_notes_author_id FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT application_notes_visibility_valid CHECK (visibility IN ('private', 'department', 'all'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_notes_application_id ON application_notes (application_id, created_at);
+
+-- Create application_tags table
+CREATE TABLE IF NOT EXISTS application_tags (
+    application_id UUID NOT NULL,
+    tag VARCHAR(50) NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_application_tags_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_application_tags_created_by FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL,
+
+    -- Constraints
+    CONSTRAINT application_tags_pkey PRIMARY KEY (application_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_tags_tag ON application_tags (tag);
@@ -0,0 +1,7 @@
+-- Rollback migration: 000021_add_blind_review
+-- This script removes blind review and the applicant reveal audit log
+
+DROP INDEX IF EXISTS idx_applicant_reveals_application_id;
+DROP TABLE IF EXISTS applicant_reveals;
+DROP FUNCTION IF EXISTS application_blind_review(UUID);
+ALTER TABLE departments DROP COLUMN IF EXISTS blind_review;
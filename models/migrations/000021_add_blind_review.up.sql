@@ -0,0 +1,39 @@
+-- Migration: 000021_add_blind_review
+-- This script adds the per-department blind review setting and the applicant reveal audit log
+
+ALTER TABLE departments ADD COLUMN IF NOT EXISTS blind_review BOOLEAN NOT NULL DEFAULT false;
+
+-- Reports whether an application's applicant is hidden from reviewers: its department reviews blind
+-- and the application has not been advanced past the department's first round
+CREATE OR REPLACE FUNCTION application_blind_review(app_id UUID)
+RETURNS BOOLEAN AS $$
+    SELECT EXISTS (
+        SELECT 1
+        FROM applications app
+        JOIN departments d ON d.slug = app.department
+        WHERE app.id = app_id
+            AND d.blind_review
+            AND NOT EXISTS (
+                SELECT 1
+                FROM application_rounds ar
+                JOIN rounds r ON r.id = ar.round_id
+                WHERE ar.application_id = app.id
+                    AND r.position > (SELECT MIN(position) FROM rounds WHERE department = app.department)
+            )
+    )
+$$ LANGUAGE sql STABLE;
+
+-- Create applicant_reveals table
+CREATE TABLE IF NOT EXISTS applicant_reveals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL,
+    revealed_by UUID,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_applicant_reveals_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_applicant_reveals_revealed_by FOREIGN KEY (revealed_by) REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_applicant_reveals_application_id ON applicant_reveals (application_id, created_at);
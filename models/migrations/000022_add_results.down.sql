@@ -0,0 +1,8 @@
+-- Rollback migration: 000022_add_results
+-- This script removes results publication
+
+DROP TRIGGER IF EXISTS update_application_results_updated_at ON application_results;
+DROP TRIGGER IF EXISTS update_department_results_updated_at ON department_results;
+DROP INDEX IF EXISTS idx_application_results_department;
+DROP TABLE IF EXISTS application_results;
+DROP TABLE IF EXISTS department_results;
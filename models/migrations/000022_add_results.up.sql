@@ -0,0 +1,55 @@
+-- Migration: 000022_add_results
+-- This script adds staged, scheduled results publication per department with applicant feedback and offers
+
+-- Create department_results table (one staged publication per department)
+CREATE TABLE IF NOT EXISTS department_results (
+    department VARCHAR(50) PRIMARY KEY,
+    publish_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    response_deadline TIMESTAMP WITH TIME ZONE,
+    announced_at TIMESTAMP WITH TIME ZONE,
+    staged_by UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_department_results_department FOREIGN KEY (department) REFERENCES departments(slug) ON DELETE CASCADE,
+    CONSTRAINT fk_department_results_staged_by FOREIGN KEY (staged_by) REFERENCES users(id) ON DELETE SET NULL,
+
+    -- Constraints
+    CONSTRAINT department_results_deadline_after_publish CHECK (response_deadline IS NULL OR response_deadline > publish_at)
+);
+
+-- Create application_results table (the outcome an applicant sees once their department's results are published)
+CREATE TABLE IF NOT EXISTS application_results (
+    application_id UUID PRIMARY KEY,
+    department VARCHAR(50) NOT NULL,
+    outcome VARCHAR(20) NOT NULL,
+    feedback TEXT NOT NULL DEFAULT '',
+    offer_status VARCHAR(20),
+    response_deadline TIMESTAMP WITH TIME ZONE,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    -- Foreign keys
+    CONSTRAINT fk_application_results_application_id FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_application_results_department FOREIGN KEY (department) REFERENCES department_results(department) ON DELETE CASCADE,
+
+    -- Constraints
+    CONSTRAINT application_results_outcome_valid CHECK (outcome IN ('selected', 'waitlisted', 'rejected', 'released')),
+    CONSTRAINT application_results_offer_status_valid CHECK (offer_status IN ('pending', 'accepted', 'declined', 'expired')),
+    CONSTRAINT application_results_offer_only_when_selected CHECK ((outcome = 'selected') = (offer_status IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_application_results_department ON application_results (department, outcome);
+
+-- Create triggers for updated_at
+CREATE TRIGGER update_department_results_updated_at
+    BEFORE UPDATE ON department_results
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_application_results_updated_at
+    BEFORE UPDATE ON application_results
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
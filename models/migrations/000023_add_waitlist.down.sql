@@ -0,0 +1,7 @@
+-- Rollback migration: 000023_add_waitlist
+-- This script removes the results waitlist
+
+ALTER TABLE application_results DROP CONSTRAINT IF EXISTS application_results_waitlist_position_unique;
+ALTER TABLE application_results DROP CONSTRAINT IF EXISTS application_results_position_only_when_waitlisted;
+ALTER TABLE application_results DROP COLUMN IF EXISTS promoted_at;
+ALTER TABLE application_results DROP COLUMN IF EXISTS waitlist_position;
@@ -0,0 +1,26 @@
+-- Migration: 000023_add_waitlist
+-- This script adds an ordered waitlist to staged results and records waitlist promotions
+
+ALTER TABLE application_results ADD COLUMN IF NOT EXISTS waitlist_position INTEGER;
+ALTER TABLE application_results ADD COLUMN IF NOT EXISTS promoted_at TIMESTAMP WITH TIME ZONE;
+
+-- Only waitlisted results hold a place; positions are renumbered in a single statement, so uniqueness is checked per statement
+ALTER TABLE application_results ADD CONSTRAINT application_results_position_only_when_waitlisted
+    CHECK (waitlist_position IS NULL OR outcome = 'waitlisted');
+ALTER TABLE application_results ADD CONSTRAINT application_results_waitlist_position_unique
+    UNIQUE (department, waitlist_position) DEFERRABLE INITIALLY IMMEDIATE;
+
+-- Order results already on a waitlist by their mean evaluation score
+UPDATE application_results r
+SET waitlist_position = ranked.position
+FROM (
+    SELECT r.application_id,
+        ROW_NUMBER() OVER (
+            PARTITION BY r.department
+            ORDER BY (SELECT AVG(e.score) FROM evaluations e WHERE e.application_id = r.application_id) DESC NULLS LAST,
+                r.created_at ASC, r.application_id ASC
+        ) AS position
+    FROM application_results r
+    WHERE r.outcome = 'waitlisted'
+) ranked
+WHERE r.application_id = ranked.application_id;
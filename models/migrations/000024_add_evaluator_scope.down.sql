@@ -0,0 +1,4 @@
+-- Rollback migration: 000024_add_evaluator_scope
+-- This script removes the evaluator department check
+
+DROP FUNCTION IF EXISTS evaluator_reviews_department(UUID, TEXT);
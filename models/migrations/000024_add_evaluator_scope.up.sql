@@ -0,0 +1,11 @@
+-- Migration: 000024_add_evaluator_scope
+-- This script adds the check that decides which departments an evaluator may review
+
+-- Reports whether an evaluator may review a department: evaluators with no evaluator_departments rows review
+-- every department, the rest only the departments listed. A NULL evaluator (an admin) reviews every department.
+CREATE OR REPLACE FUNCTION evaluator_reviews_department(evaluator_id UUID, dept TEXT)
+RETURNS BOOLEAN AS $$
+    SELECT evaluator_id IS NULL
+        OR NOT EXISTS (SELECT 1 FROM evaluator_departments WHERE user_id = evaluator_id)
+        OR EXISTS (SELECT 1 FROM evaluator_departments WHERE user_id = evaluator_id AND department = dept)
+$$ LANGUAGE sql STABLE;
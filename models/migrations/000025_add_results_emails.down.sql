@@ -0,0 +1,4 @@
+-- Rollback migration: 000025_add_results_emails
+-- This script removes the email-on-publication flag from applications
+
+ALTER TABLE applications DROP COLUMN IF EXISTS email_on_results;
@@ -0,0 +1,4 @@
+-- Migration: 000025_add_results_emails
+-- This script records which applicants are emailed their decision once their department's results are published
+
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS email_on_results BOOLEAN NOT NULL DEFAULT false;
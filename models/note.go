@@ -0,0 +1,63 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Note visibility levels
+const (
+	NoteVisibilityPrivate    = "private"    // Only the author
+	NoteVisibilityDepartment = "department" // Evaluators of the application's department and admins
+	NoteVisibilityAll        = "all"        // Every evaluator and admin
+)
+
+// NoteVisibilities lists every note visibility level
+var NoteVisibilities = []string{NoteVisibilityPrivate, NoteVisibilityDepartment, NoteVisibilityAll}
+
+// tagPattern is the shape of a normalised tag, such as strong-frontend
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// ApplicationNote is an evaluator's note on an application
+type ApplicationNote struct {
+	ID            uuid.UUID `json:"id"`
+	ApplicationID uuid.UUID `json:"application_id"`
+	AuthorID      uuid.UUID `json:"author_id"`
+	AuthorName    string    `json:"author_name"`
+	Body          string    `json:"body"`
+	Visibility    string    `json:"visibility"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ApplicationNoteRequest represents the request body for writing or editing a note
+type ApplicationNoteRequest struct {
+	Body       string `json:"body" binding:"required,max=10000"`
+	Visibility string `json:"visibility" binding:"omitempty,oneof=private department all"` // Defaults to private
+}
+
+// ApplicationTag is a free-form label on an application
+type ApplicationTag struct {
+	ApplicationID uuid.UUID  `json:"application_id"`
+	Tag           string     `json:"tag"`
+	CreatedBy     *uuid.UUID `json:"created_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// AddApplicationTagRequest represents the request body for tagging an application
+type AddApplicationTagRequest struct {
+	Tag string `json:"tag" binding:"required"`
+}
+
+// NormaliseTag lower-cases a tag, turns spaces into dashes and checks what is left
+func NormaliseTag(tag string) (string, error) {
+	tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+	if !tagPattern.MatchString(tag) {
+		return "", fmt.Errorf("tags are up to 50 letters, digits, dashes or underscores")
+	}
+	return tag, nil
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType represents the kind of in-app notification
+type NotificationType string
+
+const (
+	NotificationSubmissionReceived NotificationType = "submission_received"
+	NotificationStatusChanged      NotificationType = "status_changed"
+	NotificationRoundAdvanced      NotificationType = "round_advanced"
+	NotificationResultsPublished   NotificationType = "results_published"
+	NotificationWaitlistPromoted   NotificationType = "waitlist_promoted"
+)
+
+// Notification represents an in-app notification for a user
+type Notification struct {
+	ID        uuid.UUID        `json:"id" db:"id"`
+	UserID    uuid.UUID        `json:"user_id" db:"user_id"`
+	Type      NotificationType `json:"type" db:"type"`
+	Title     string           `json:"title" db:"title"`
+	Body      string           `json:"body" db:"body"`
+	Data      json.RawMessage  `json:"data,omitempty" db:"data"`
+	ReadAt    *time.Time       `json:"read_at" db:"read_at"`
+	CreatedAt time.Time        `json:"created_at" db:"created_at"`
+}
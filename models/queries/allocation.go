@@ -0,0 +1,24 @@
+package queries
+
+// Allocation SQL queries
+
+const (
+	// GetAllocationCandidatesQuery lists selected applications with their average evaluation score
+	GetAllocationCandidatesQuery = `
+		SELECT app.id, app.user_id, app.department::text, app.preference,
+			COALESCE(AVG(e.score), 0)::float8, app.submitted_at
+		FROM applications app
+		LEFT JOIN evaluations e ON e.application_id = app.id
+		WHERE app.status = 'selected'
+		GROUP BY app.id
+		ORDER BY app.user_id, app.preference NULLS LAST, app.created_at
+	`
+
+	// SetAllocationOutcomeQuery moves a selected application to its allocation outcome
+	SetAllocationOutcomeQuery = `
+		UPDATE applications
+		SET status = $2, updated_at = $3
+		WHERE id = $1 AND status = 'selected'
+		RETURNING id, user_id, department, submitted, status, preference, created_at, updated_at
+	`
+)
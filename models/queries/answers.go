@@ -6,17 +6,54 @@ FROM answers WHERE application_id = $1
 `
 
 const CheckApplicationOwnershipQuery = `
-SELECT user_id FROM applications WHERE id = $1 AND submitted = false
+SELECT user_id FROM applications WHERE id = $1 AND status = 'draft'
 `
 
+// UpsertAnswerQuery saves an answer and records a revision when its text changes.
+// When $8 is not NULL an existing answer is only updated if its version still equals $8.
+// No row is returned if the update was skipped, either because the version is stale or the text is unchanged.
 const UpsertAnswerQuery = `
-INSERT INTO answers (id, application_id, user_id, question_id, body, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
-ON CONFLICT (application_id, question_id)
-DO UPDATE SET 
-    body = EXCLUDED.body,
-    updated_at = EXCLUDED.updated_at
-RETURNING id, application_id, user_id, question_id, body, created_at, updated_at
+WITH saved AS (
+    INSERT INTO answers (id, application_id, user_id, question_id, body, question_version, created_at, updated_at)
+    VALUES ($1, $2, $3, $4, $5, (SELECT version FROM questions WHERE id = $4), $6, $7)
+    ON CONFLICT (application_id, question_id)
+    DO UPDATE SET 
+        body = EXCLUDED.body,
+        question_version = EXCLUDED.question_version,
+        updated_at = EXCLUDED.updated_at,
+        version = answers.version + 1
+    WHERE ($8::integer IS NULL OR answers.version = $8::integer)
+        AND answers.body IS DISTINCT FROM EXCLUDED.body
+    RETURNING id, application_id, user_id, question_id, body, version, question_version, created_at, updated_at
+), revision AS (
+    INSERT INTO answer_revisions (answer_id, version, body, created_at)
+    SELECT id, version, body, updated_at FROM saved
+)
+SELECT id, application_id, user_id, question_id, body, version, question_version, created_at, updated_at FROM saved
+`
+
+const GetAnswerByApplicationQuestionQuery = `
+SELECT id, application_id, user_id, question_id, body, version, question_version, created_at, updated_at
+FROM answers
+WHERE application_id = $1 AND question_id = $2
+`
+
+const GetApplicationAnswerVersionsQuery = `
+SELECT question_id, version
+FROM answers
+WHERE application_id = $1
+ORDER BY question_id
+`
+
+const LockApplicationForUpdateQuery = `
+SELECT id FROM applications WHERE id = $1 FOR UPDATE
+`
+
+const GetAnswerRevisionsQuery = `
+SELECT id, answer_id, version, body, created_at
+FROM answer_revisions
+WHERE answer_id = $1
+ORDER BY version DESC
 `
 
 const DeleteAnswerQuery = `
@@ -25,28 +62,57 @@ WHERE id = $1 AND application_id = $2 AND user_id = $3
 `
 
 const GetAnswerByIDQuery = `
-SELECT id, application_id, user_id, question_id, body, created_at, updated_at
+SELECT id, application_id, user_id, question_id, body, version, question_version, created_at, updated_at
 FROM answers 
 WHERE id = $1
 `
 
 const GetUserAnswersForApplicationQuery = `
-SELECT a.id, a.application_id, a.user_id, a.question_id, a.body, a.created_at, a.updated_at
+SELECT a.id, a.application_id, a.user_id, a.question_id, a.body, a.version, a.question_version, a.created_at, a.updated_at
 FROM answers a
 INNER JOIN applications app ON a.application_id = app.id
 WHERE a.application_id = $1 AND app.user_id = $2
 ORDER BY a.created_at ASC
 `
 
-const GetAnswersByUserQuery = `
-SELECT id, application_id, user_id, question_id, body, created_at, updated_at
-FROM answers 
-WHERE user_id = $1
-ORDER BY created_at DESC
+// GetUserDepartmentAnswersQuery fetches the answers on a user's application to a department
+const GetUserDepartmentAnswersQuery = `
+SELECT a.question_id, a.body
+FROM answers a
+INNER JOIN applications app ON a.application_id = app.id
+WHERE app.user_id = $1 AND app.department = $2
 `
 
+// ListAnswersColumns and ListAnswersFrom are the base of the paginated answer listing; the question is joined so
+// filters can use its department
+const (
+	ListAnswersColumns = `a.id, a.application_id, a.user_id, a.question_id, a.body, a.version, a.question_version, a.created_at, a.updated_at`
+	ListAnswersFrom    = `answers a JOIN questions q ON q.id = a.question_id`
+)
+
+// AnswerSortFields are the columns answers can be sorted by
+var AnswerSortFields = map[string]SortField{
+	"created_at": {Column: "a.created_at", Type: "timestamptz"},
+	"updated_at": {Column: "a.updated_at", Type: "timestamptz"},
+}
+
 const ValidateQuestionApplicationDepartmentQuery = `
-SELECT app.department as app_department, q.department as question_department
+SELECT app.department as app_department, q.department as question_department,
+	(q.domain IS NULL OR EXISTS (
+		SELECT 1 FROM application_domains ad WHERE ad.application_id = app.id AND ad.domain = q.domain
+	)) as domain_applicable,
+	q.type, q.options,
+	EXISTS (
+		SELECT 1 FROM application_rounds ar WHERE ar.application_id = app.id AND ar.round_id = q.round_id
+	) as round_admitted,
+	q.round_id = (
+		SELECT r.id FROM rounds r WHERE r.department = q.department ORDER BY r.position ASC, r.created_at ASC LIMIT 1
+	) as first_round
 FROM applications app, questions q
-WHERE app.id = $1 AND q.id = $2
+WHERE app.id = $1 AND q.id = $2 AND q.archived_at IS NULL
+`
+
+// GetApplicationOwnerStatusQuery fetches an application's owner and status, whatever the status
+const GetApplicationOwnerStatusQuery = `
+SELECT user_id, status FROM applications WHERE id = $1
 `
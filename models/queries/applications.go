@@ -1,19 +1,43 @@
 package queries
 
-const GetAllApplicationsQuery = `
-SELECT id, user_id, department, submitted, created_at, updated_at
+// ListApplicationsColumns and ListApplicationsFrom are the base of the paginated application listing; the applicant
+// is joined so filters can search by name, email and registration number, and the domains are aggregated per application
+const (
+	ListApplicationsColumns = `
+	app.id, app.user_id, app.department, app.submitted, app.status, app.preference, app.created_at, app.updated_at,
+	d.domains`
+	ListApplicationsFrom = `
+applications app
+JOIN users u ON u.id = app.user_id
+LEFT JOIN LATERAL (
+	SELECT COALESCE(array_agg(ad.domain ORDER BY ad.domain), '{}') AS domains
+	FROM application_domains ad
+	WHERE ad.application_id = app.id
+) d ON true`
+)
+
+// ApplicationSortFields are the columns applications can be sorted by
+var ApplicationSortFields = map[string]SortField{
+	"created_at": {Column: "app.created_at", Type: "timestamptz"},
+	"updated_at": {Column: "app.updated_at", Type: "timestamptz"},
+	"department": {Column: "app.department::text", Type: "text"},
+	"status":     {Column: "app.status", Type: "text"},
+}
+
+const GetApplicationByIDQuery = `
+SELECT id, user_id, department, submitted, status, preference, created_at, updated_at
 FROM applications 
-ORDER BY created_at DESC
+WHERE id = $1
 `
 
 const CreateApplicationQuery = `
 INSERT INTO applications (id, user_id, department, submitted, created_at, updated_at)
 VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, user_id, department, submitted, created_at, updated_at
+RETURNING id, user_id, department, submitted, status, preference, created_at, updated_at
 `
 
 const GetUserApplicationsQuery = `
-SELECT id, user_id, department, submitted, created_at, updated_at
+SELECT id, user_id, department, submitted, status, preference, created_at, updated_at
 FROM applications 
 WHERE user_id = $1
 ORDER BY created_at DESC
@@ -21,9 +45,9 @@ ORDER BY created_at DESC
 
 const SubmitApplicationQuery = `
 UPDATE applications 
-SET submitted = true, updated_at = $2
-WHERE id = $1 AND user_id = $3
-RETURNING id, user_id, department, submitted, created_at, updated_at
+SET submitted = true, status = 'submitted', submitted_at = COALESCE(submitted_at, $2), updated_at = $2
+WHERE id = $1 AND user_id = $3 AND status <> 'withdrawn'
+RETURNING id, user_id, department, submitted, status, preference, created_at, updated_at
 `
 
 const DeleteApplicationQuery = `
@@ -34,3 +58,67 @@ WHERE id = $1 AND user_id = $2 AND submitted = false
 const CountUserApplicationsQuery = `
 SELECT COUNT(*) FROM applications WHERE user_id = $1
 `
+
+const LockUserApplicationQuery = `
+SELECT id, user_id, department, submitted, status, preference, created_at, updated_at
+FROM applications
+WHERE id = $1 AND user_id = $2
+FOR UPDATE
+`
+
+const UpdateApplicationStatusQuery = `
+UPDATE applications
+SET status = $2, updated_at = $3
+WHERE id = $1
+RETURNING id, user_id, department, submitted, status, preference, created_at, updated_at
+`
+
+const GetActiveUserApplicationIDsQuery = `
+SELECT id FROM applications WHERE user_id = $1 AND status <> 'withdrawn'
+`
+
+const ClearUserApplicationPreferencesQuery = `
+UPDATE applications SET preference = NULL WHERE user_id = $1 AND preference IS NOT NULL
+`
+
+const SetApplicationPreferenceQuery = `
+UPDATE applications
+SET preference = $3, updated_at = $4
+WHERE id = $1 AND user_id = $2 AND status <> 'withdrawn'
+RETURNING id, user_id, department, submitted, status, preference, created_at, updated_at
+`
+
+const InsertApplicationDomainsQuery = `
+INSERT INTO application_domains (application_id, department, domain)
+SELECT $1, $2, unnest($3::text[])
+`
+
+const GetApplicationDomainsQuery = `
+SELECT ARRAY(SELECT domain FROM application_domains WHERE application_id = $1 ORDER BY domain)
+`
+
+const GetUserApplicationDomainsQuery = `
+SELECT ad.application_id, ad.domain
+FROM application_domains ad
+INNER JOIN applications app ON app.id = ad.application_id
+WHERE app.user_id = $1
+ORDER BY ad.domain
+`
+
+// GetApplicationQuestionAnswersQuery lists the live questions of the application's admitted rounds, with its answer to each
+// (blank when unanswered), so required questions and visibility rules can be checked before submission
+const GetApplicationQuestionAnswersQuery = `
+SELECT q.id, q.type, q.body, q.required, COALESCE(a.body, '')
+FROM questions q
+INNER JOIN applications app ON app.department = q.department
+LEFT JOIN answers a ON a.application_id = app.id AND a.question_id = q.id
+WHERE app.id = $1 AND app.user_id = $2
+	AND q.archived_at IS NULL
+	AND (q.domain IS NULL OR EXISTS (
+		SELECT 1 FROM application_domains ad WHERE ad.application_id = app.id AND ad.domain = q.domain
+	))
+	AND EXISTS (
+		SELECT 1 FROM application_rounds ar WHERE ar.application_id = app.id AND ar.round_id = q.round_id
+	)
+ORDER BY q.position ASC, q.created_at ASC
+`
@@ -0,0 +1,41 @@
+package queries
+
+// Blind review SQL queries
+
+const (
+	// GetApplicationApplicantQuery fetches the applicant of an application and whether it is under blind review
+	GetApplicationApplicantQuery = `
+		SELECT u.id, u.full_name, u.email, u.reg_num, u.phone_number, u.verified, u.role, u.chickened_out, u.created_at, u.updated_at,
+			app.department, application_blind_review(app.id)
+		FROM applications app
+		INNER JOIN users u ON u.id = app.user_id
+		WHERE app.id = $1
+	`
+
+	// ApplicationBlindReviewQuery reports whether an application is under blind review
+	ApplicationBlindReviewQuery = `SELECT application_blind_review($1)`
+
+	// UserUnderBlindReviewQuery reports whether a user has an application under blind review
+	UserUnderBlindReviewQuery = `
+		SELECT EXISTS(SELECT 1 FROM applications app WHERE app.user_id = $1 AND application_blind_review(app.id))
+	`
+
+	// NotUnderBlindReviewCondition keeps users without an application under blind review in the user listing
+	NotUnderBlindReviewCondition = `NOT EXISTS (SELECT 1 FROM applications app WHERE app.user_id = users.id AND application_blind_review(app.id))`
+
+	// CreateApplicantRevealQuery records an admin de-anonymising an applicant
+	CreateApplicantRevealQuery = `
+		INSERT INTO applicant_reveals (id, application_id, revealed_by, reason, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	// GetApplicantRevealsQuery lists the reveal audit log, newest first, optionally for one application ($1) and limited to $2
+	GetApplicantRevealsQuery = `
+		SELECT r.id, r.application_id, r.revealed_by, COALESCE(u.full_name, ''), r.reason, r.created_at
+		FROM applicant_reveals r
+		LEFT JOIN users u ON u.id = r.revealed_by
+		WHERE $1::uuid IS NULL OR r.application_id = $1
+		ORDER BY r.created_at DESC
+		LIMIT $2
+	`
+)
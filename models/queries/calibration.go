@@ -0,0 +1,17 @@
+package queries
+
+// Score calibration SQL queries
+
+const (
+	// GetCalibrationScoresQuery lists the evaluations of submitted applications with evaluator names,
+	// optionally for one department ($1)
+	GetCalibrationScoresQuery = `
+		SELECT e.id, e.application_id, app.department, e.evaluator_id, u.full_name, e.score
+		FROM evaluations e
+		INNER JOIN applications app ON app.id = e.application_id
+		INNER JOIN users u ON u.id = e.evaluator_id
+		WHERE app.status NOT IN ('draft', 'withdrawn')
+			AND ($1 = '' OR app.department = $1)
+		ORDER BY e.created_at ASC
+	`
+)
@@ -0,0 +1,111 @@
+package queries
+
+// Department-related SQL queries
+
+const (
+	// departmentColumns selects a department with its lead admin IDs; used with the departments table aliased as d
+	departmentColumns = `
+		d.slug, d.name, d.description, d.active, d.capacity, d.blind_review,
+		COALESCE((SELECT array_agg(l.user_id ORDER BY l.created_at) FROM department_leads l WHERE l.department = d.slug), '{}'),
+		d.created_at, d.updated_at
+	`
+
+	// GetAllDepartmentsQuery lists every department, including inactive ones
+	GetAllDepartmentsQuery = `SELECT ` + departmentColumns + ` FROM departments d ORDER BY d.name ASC`
+
+	// GetActiveDepartmentsQuery lists the departments currently accepting applications
+	GetActiveDepartmentsQuery = `
+		SELECT slug, name, description, active, capacity, created_at, updated_at
+		FROM departments
+		WHERE active = true
+		ORDER BY name ASC
+	`
+
+	// GetDepartmentBySlugQuery fetches a single department
+	GetDepartmentBySlugQuery = `SELECT ` + departmentColumns + ` FROM departments d WHERE d.slug = $1`
+
+	// DepartmentExistsQuery reports whether a department exists and whether it is active
+	DepartmentExistsQuery = `
+		SELECT EXISTS(SELECT 1 FROM departments WHERE slug = $1),
+			EXISTS(SELECT 1 FROM departments WHERE slug = $1 AND active = true)
+	`
+
+	// CreateDepartmentQuery inserts a new department
+	CreateDepartmentQuery = `
+		INSERT INTO departments (slug, name, description, active, capacity, blind_review, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+	`
+
+	// UpdateDepartmentQuery replaces a department's settings
+	UpdateDepartmentQuery = `
+		UPDATE departments
+		SET name = $2, description = $3, active = $4, capacity = $5, blind_review = $6, updated_at = $7
+		WHERE slug = $1
+	`
+
+	// DeleteDepartmentQuery deletes a department; fails while questions or applications reference it
+	DeleteDepartmentQuery = `DELETE FROM departments WHERE slug = $1`
+
+	// DeleteDepartmentLeadsQuery clears a department's lead admins
+	DeleteDepartmentLeadsQuery = `DELETE FROM department_leads WHERE department = $1`
+
+	// InsertDepartmentLeadsQuery assigns lead admins to a department
+	InsertDepartmentLeadsQuery = `
+		INSERT INTO department_leads (department, user_id)
+		SELECT $1, unnest($2::uuid[])
+	`
+
+	// CountAdminUsersQuery counts how many of the given users are admins or super admins
+	CountAdminUsersQuery = `
+		SELECT COUNT(*)
+		FROM users
+		WHERE id = ANY($1::uuid[]) AND role IN ('admin', 'super_admin')
+	`
+
+	// GetDepartmentCapacitiesQuery lists the departments with a seat limit
+	GetDepartmentCapacitiesQuery = `
+		SELECT slug, capacity
+		FROM departments
+		WHERE capacity IS NOT NULL
+	`
+
+	// GetAllDepartmentDomainsQuery lists every sub-domain, grouped by department
+	GetAllDepartmentDomainsQuery = `
+		SELECT department, slug, name, description, created_at, updated_at
+		FROM department_domains
+		ORDER BY department ASC, name ASC
+	`
+
+	// GetDepartmentDomainsQuery lists the sub-domains of a department
+	GetDepartmentDomainsQuery = `
+		SELECT department, slug, name, description, created_at, updated_at
+		FROM department_domains
+		WHERE department = $1
+		ORDER BY name ASC
+	`
+
+	// CountDepartmentDomainsQuery counts how many of the given slugs ($2) are sub-domains of department $1
+	CountDepartmentDomainsQuery = `
+		SELECT COUNT(*)
+		FROM department_domains
+		WHERE department = $1 AND slug = ANY($2::text[])
+	`
+
+	// CreateDepartmentDomainQuery inserts a new sub-domain
+	CreateDepartmentDomainQuery = `
+		INSERT INTO department_domains (department, slug, name, description, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		RETURNING department, slug, name, description, created_at, updated_at
+	`
+
+	// UpdateDepartmentDomainQuery renames a sub-domain
+	UpdateDepartmentDomainQuery = `
+		UPDATE department_domains
+		SET name = $3, description = $4, updated_at = $5
+		WHERE department = $1 AND slug = $2
+		RETURNING department, slug, name, description, created_at, updated_at
+	`
+
+	// DeleteDepartmentDomainQuery deletes a sub-domain; fails while questions target it
+	DeleteDepartmentDomainQuery = `DELETE FROM department_domains WHERE department = $1 AND slug = $2`
+)
@@ -0,0 +1,48 @@
+package queries
+
+// Dossier-related SQL queries
+
+const (
+	// GetDossierApplicationQuery fetches an application together with its applicant and whether it is under blind review
+	GetDossierApplicationQuery = `
+		SELECT
+			app.id, app.user_id, app.department, app.submitted, app.status, app.preference, app.created_at, app.updated_at,
+			u.id, u.full_name, u.email, u.reg_num, u.phone_number, u.verified, u.role, u.chickened_out, u.created_at, u.updated_at,
+			application_blind_review(app.id)
+		FROM applications app
+		INNER JOIN users u ON u.id = app.user_id
+		WHERE app.id = $1
+	`
+
+	// GetDossierAnswersQuery fetches every question of the application's department with its answer, in order.
+	// Answered questions show the wording the applicant saw; archived questions only appear if answered.
+	GetDossierAnswersQuery = `
+		SELECT q.id, COALESCE(qv.body, q.body), COALESCE(a.body, '')
+		FROM questions q
+		LEFT JOIN answers a ON a.question_id = q.id AND a.application_id = $1
+		LEFT JOIN question_versions qv ON qv.question_id = q.id AND qv.version = a.question_version
+		WHERE q.department = (SELECT department FROM applications WHERE id = $1)
+			AND (q.domain IS NULL OR q.domain IN (SELECT domain FROM application_domains WHERE application_id = $1))
+			AND (q.archived_at IS NULL OR a.id IS NOT NULL)
+		ORDER BY q.position ASC, q.created_at ASC
+	`
+
+	// GetDossierEvaluationsQuery fetches the evaluations of an application with evaluator names
+	GetDossierEvaluationsQuery = `
+		SELECT u.full_name, e.score, e.notes, e.updated_at
+		FROM evaluations e
+		INNER JOIN users u ON u.id = e.evaluator_id
+		WHERE e.application_id = $1
+		ORDER BY e.created_at ASC
+	`
+
+	// GetSubmittedApplicationIDsByDepartmentQuery lists the submitted applications of a department,
+	// optionally only those that picked the sub-domain in $2
+	GetSubmittedApplicationIDsByDepartmentQuery = `
+		SELECT id
+		FROM applications app
+		WHERE department::text = $1 AND status NOT IN ('draft', 'withdrawn')
+			AND ($2 = '' OR EXISTS (SELECT 1 FROM application_domains ad WHERE ad.application_id = app.id AND ad.domain = $2))
+		ORDER BY created_at ASC
+	`
+)
@@ -0,0 +1,24 @@
+package queries
+
+// Evaluation-related SQL queries
+
+const (
+	// UpsertEvaluationQuery records or updates an evaluator's evaluation of an application
+	UpsertEvaluationQuery = `
+		INSERT INTO evaluations (application_id, evaluator_id, score, notes)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (application_id, evaluator_id)
+		DO UPDATE SET
+			score = EXCLUDED.score,
+			notes = EXCLUDED.notes
+		RETURNING id, application_id, evaluator_id, score, notes, created_at, updated_at
+	`
+
+	// GetApplicationEvaluationsQuery retrieves all evaluations of an application
+	GetApplicationEvaluationsQuery = `
+		SELECT id, application_id, evaluator_id, score, notes, created_at, updated_at
+		FROM evaluations
+		WHERE application_id = $1
+		ORDER BY created_at ASC
+	`
+)
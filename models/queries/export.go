@@ -0,0 +1,35 @@
+package queries
+
+// Export-related SQL queries
+
+const (
+	// GetExportQuestionsQuery fetches the questions that become pivoted export columns
+	GetExportQuestionsQuery = `
+		SELECT id, department, domain, body, created_at
+		FROM questions
+		WHERE ($1 = '' OR department::text = $1)
+		ORDER BY department ASC, domain ASC NULLS FIRST, position ASC, created_at ASC
+	`
+
+	// DeclareExportApplicationsCursorQuery opens a server-side cursor over the applications to export.
+	// Each row carries the applicant and a question_id -> body map of answers.
+	DeclareExportApplicationsCursorQuery = `
+		DECLARE export_applications NO SCROLL CURSOR FOR
+		SELECT
+			app.id, app.department::text, app.status, app.submitted_at, app.created_at, app.updated_at,
+			u.id, u.full_name, u.email, u.reg_num, u.phone_number, u.verified, u.chickened_out, u.role, u.created_at, u.updated_at,
+			COALESCE((
+				SELECT jsonb_object_agg(a.question_id::text, a.body)
+				FROM answers a
+				WHERE a.application_id = app.id
+			), '{}'::jsonb)
+		FROM applications app
+		INNER JOIN users u ON u.id = app.user_id
+		WHERE ($1 = '' OR app.department::text = $1)
+			AND ($2 = '' OR app.status = $2)
+		ORDER BY app.department ASC, app.created_at ASC
+	`
+
+	// FetchExportApplicationsQuery reads the next batch from the export cursor
+	FetchExportApplicationsQuery = `FETCH 500 FROM export_applications`
+)
@@ -0,0 +1,76 @@
+package queries
+
+// Application note and tag SQL queries
+
+const (
+	// applicationNoteColumns selects a note with its author's name; used with application_notes aliased as n and users as u
+	applicationNoteColumns = `n.id, n.application_id, n.author_id, u.full_name, n.body, n.visibility, n.created_at, n.updated_at`
+
+	// GetApplicationNotesQuery lists the notes on application $1 that viewer $2 may read, oldest first.
+	// Private notes are only shown to their author. Department notes are shown to admins ($3) and to evaluators
+	// who are unrestricted or restricted to the application's department.
+	GetApplicationNotesQuery = `
+		SELECT ` + applicationNoteColumns + `
+		FROM application_notes n
+		JOIN users u ON u.id = n.author_id
+		JOIN applications app ON app.id = n.application_id
+		WHERE n.application_id = $1
+			AND (
+				n.author_id = $2
+				OR n.visibility = 'all'
+				OR (n.visibility = 'department' AND ($3 OR evaluator_reviews_department($2, app.department)))
+			)
+		ORDER BY n.created_at ASC
+	`
+
+	// CreateApplicationNoteQuery adds a note to an application
+	CreateApplicationNoteQuery = `
+		WITH n AS (
+			INSERT INTO application_notes (id, application_id, author_id, body, visibility, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $6)
+			RETURNING *
+		)
+		SELECT ` + applicationNoteColumns + ` FROM n JOIN users u ON u.id = n.author_id
+	`
+
+	// UpdateApplicationNoteQuery edits a note on application $2; only its author ($5) may
+	UpdateApplicationNoteQuery = `
+		WITH n AS (
+			UPDATE application_notes
+			SET body = $3, visibility = $4, updated_at = $6
+			WHERE id = $1 AND application_id = $2 AND author_id = $5
+			RETURNING *
+		)
+		SELECT ` + applicationNoteColumns + ` FROM n JOIN users u ON u.id = n.author_id
+	`
+
+	// GetApplicationNoteAuthorQuery fetches the author of a note on an application
+	GetApplicationNoteAuthorQuery = `
+		SELECT author_id FROM application_notes WHERE id = $1 AND application_id = $2
+	`
+
+	// DeleteApplicationNoteQuery removes a note from an application
+	DeleteApplicationNoteQuery = `
+		DELETE FROM application_notes WHERE id = $1 AND application_id = $2
+	`
+
+	// GetApplicationTagsQuery lists an application's tags alphabetically
+	GetApplicationTagsQuery = `
+		SELECT application_id, tag, created_by, created_at
+		FROM application_tags
+		WHERE application_id = $1
+		ORDER BY tag ASC
+	`
+
+	// AddApplicationTagQuery tags an application; tagging twice keeps the first tag
+	AddApplicationTagQuery = `
+		INSERT INTO application_tags (application_id, tag, created_by, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (application_id, tag) DO NOTHING
+	`
+
+	// DeleteApplicationTagQuery removes a tag from an application
+	DeleteApplicationTagQuery = `
+		DELETE FROM application_tags WHERE application_id = $1 AND tag = $2
+	`
+)
@@ -0,0 +1,41 @@
+package queries
+
+// Notification-related SQL queries
+
+const (
+	// CreateNotificationQuery inserts a new notification for a user
+	CreateNotificationQuery = `
+		INSERT INTO notifications (user_id, type, title, body, data)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, user_id, type, title, body, data, read_at, created_at
+	`
+
+	// GetUserNotificationsQuery retrieves a user's notifications, optionally only unread ones
+	GetUserNotificationsQuery = `
+		SELECT id, user_id, type, title, body, data, read_at, created_at
+		FROM notifications
+		WHERE user_id = $1 AND ($2 = false OR read_at IS NULL)
+		ORDER BY created_at DESC
+		LIMIT $3
+	`
+
+	// CountUnreadNotificationsQuery counts a user's unread notifications
+	CountUnreadNotificationsQuery = `
+		SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL
+	`
+
+	// MarkNotificationReadQuery marks a single notification of a user as read
+	MarkNotificationReadQuery = `
+		UPDATE notifications
+		SET read_at = COALESCE(read_at, NOW())
+		WHERE id = $1 AND user_id = $2
+		RETURNING id, user_id, type, title, body, data, read_at, created_at
+	`
+
+	// MarkAllNotificationsReadQuery marks all unread notifications of a user as read
+	MarkAllNotificationsReadQuery = `
+		UPDATE notifications
+		SET read_at = NOW()
+		WHERE user_id = $1 AND read_at IS NULL
+	`
+)
@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SortField is a whitelisted sort expression and the SQL type its cursor value is cast back to
+type SortField struct {
+	Column string
+	Type   string
+}
+
+// Keyset is the position of the last row of a page; the next page starts after it
+type Keyset struct {
+	Value string
+	ID    string
+}
+
+// ListQuery builds a filtered, keyset-paginated SELECT.
+// Filters are added with "?" placeholders and always bound as positional parameters;
+// only whitelisted SortField expressions are interpolated into the SQL text.
+type ListQuery struct {
+	columns    string
+	fromClause string
+	idColumn   string
+	conditions []string
+	args       []any
+}
+
+// NewListQuery starts a list query from its select list, its FROM clause (tables and joins, without the
+// FROM keyword) and the row's unique ID column. They are kept apart so the sort key can be appended to the
+// select list without parsing SQL.
+func NewListQuery(columns, fromClause, idColumn string) *ListQuery {
+	return &ListQuery{columns: columns, fromClause: fromClause, idColumn: idColumn}
+}
+
+// Where adds a condition; each "?" in it is bound to the next argument
+func (q *ListQuery) Where(condition string, args ...any) *ListQuery {
+	var b strings.Builder
+	next := 0
+	for _, r := range condition {
+		if r == '?' && next < len(args) {
+			q.args = append(q.args, args[next])
+			fmt.Fprintf(&b, "$%d", len(q.args))
+			next++
+			continue
+		}
+		b.WriteRune(r)
+	}
+	q.conditions = append(q.conditions, "("+b.String()+")")
+	return q
+}
+
+// Build returns the SQL and arguments for one page. The sort key is selected as the last
+// column (as text) so callers can build the next cursor, and limit+1 rows are requested
+// so callers can tell whether another page exists.
+func (q *ListQuery) Build(sort SortField, desc bool, after *Keyset, limit int) (string, []any) {
+	conditions := append([]string{}, q.conditions...)
+	args := append([]any{}, q.args...)
+
+	direction, comparison := "ASC", ">"
+	if desc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if after != nil {
+		args = append(args, after.Value, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(%s, %s) %s ($%d::%s, $%d::uuid)",
+			sort.Column, q.idColumn, comparison, len(args)-1, sort.Type, len(args)))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "SELECT %s, (%s)::text FROM %s", q.columns, sort.Column, q.fromClause)
+	if len(conditions) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(conditions, " AND "))
+	}
+
+	args = append(args, limit+1)
+	fmt.Fprintf(&b, " ORDER BY %s %s, %s %s LIMIT $%d", sort.Column, direction, q.idColumn, direction, len(args))
+
+	return b.String(), args
+}
+
+// LikePattern escapes LIKE wildcards in user input and wraps it for a substring match
+func LikePattern(search string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + replacer.Replace(search) + "%"
+}
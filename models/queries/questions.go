@@ -3,38 +3,184 @@ package queries
 // Questions-related SQL queries
 
 const (
-	// GetQuestionsByDepartmentQuery fetches all questions for a department
+	// GetQuestionsByDepartmentQuery fetches the live questions for a department in display order. When sub-domains
+	// are given in $2, only department-wide questions and questions for those sub-domains are returned.
+	// Only rounds that user $3's application has been admitted to are included; without an application, the first round.
 	GetQuestionsByDepartmentQuery = `
-		SELECT id, department, body, created_at
+		SELECT id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at
 		FROM questions
-		WHERE department = $1
-		ORDER BY created_at ASC
+		WHERE department = $1 AND archived_at IS NULL
+			AND (cardinality($2::text[]) = 0 OR domain IS NULL OR domain = ANY($2::text[]))
+			AND (
+				round_id IN (
+					SELECT ar.round_id
+					FROM application_rounds ar
+					JOIN applications app ON app.id = ar.application_id
+					WHERE app.user_id = $3 AND app.department = $1
+				)
+				OR (
+					NOT EXISTS (SELECT 1 FROM applications WHERE user_id = $3 AND department = $1)
+					AND round_id = (SELECT id FROM rounds WHERE department = $1 ORDER BY position ASC, created_at ASC LIMIT 1)
+				)
+			)
+		ORDER BY position ASC, created_at ASC
 	`
 
-	// GetAllQuestionsQuery fetches all questions from all departments
-	GetAllQuestionsQuery = `
-		SELECT id, department, body, created_at
-		FROM questions
-		ORDER BY department ASC, created_at ASC
-	`
+	// ListQuestionsColumns and ListQuestionsFrom are the base of the paginated question listing; filters are added with a ListQuery
+	ListQuestionsColumns = `id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at`
+	ListQuestionsFrom    = `questions`
 
 	// GetQuestionByIDQuery fetches a specific question by ID
 	GetQuestionByIDQuery = `
-		SELECT id, department, body, created_at
+		SELECT id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at
 		FROM questions
 		WHERE id = $1
 	`
 
-	// DeleteQuestionByIDQuery deletes a specific question by ID
+	// DeleteQuestionByIDQuery deletes a specific question by ID, as long as nobody has answered it
 	DeleteQuestionByIDQuery = `
 		DELETE FROM questions
-		WHERE id = $1
+		WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM answers WHERE question_id = $1)
 	`
 
-	// CreateQuestionQuery inserts a new question
+	// QuestionHasAnswersQuery reports whether anyone has answered a question
+	QuestionHasAnswersQuery = `
+		SELECT EXISTS (SELECT 1 FROM answers WHERE question_id = $1)
+	`
+
+	// CreateQuestionQuery inserts a new question at the end of its department and records its first version.
+	// Without a round ($9) the question goes into the department's first round.
 	CreateQuestionQuery = `
-		INSERT INTO questions (id, department, body, created_at)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id, department, body, created_at
+		WITH created AS (
+			INSERT INTO questions (id, department, domain, type, body, options, required, round_id, position, created_at, updated_at)
+			VALUES (
+				$1, $2, $3, $4, $5, COALESCE($6::text[], '{}'), $7,
+				COALESCE($9::uuid, (SELECT id FROM rounds WHERE department = $2 ORDER BY position ASC, created_at ASC LIMIT 1)),
+				(SELECT COALESCE(MAX(position), 0) + 1 FROM questions WHERE department = $2), $8, $8
+			)
+			RETURNING id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at
+		), first_version AS (
+			INSERT INTO question_versions (question_id, version, body, created_at)
+			SELECT id, version, body, created_at FROM created
+		)
+		SELECT id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at FROM created
+	`
+
+	// UpdateQuestionQuery changes a live question's wording, sub-domain, type, options, required flag and,
+	// when $8 is given, its round. A new version is recorded only when the wording changes.
+	UpdateQuestionQuery = `
+		WITH updated AS (
+			UPDATE questions
+			SET body = $2,
+				domain = $3,
+				type = $4,
+				options = COALESCE($5::text[], '{}'),
+				required = $6,
+				round_id = COALESCE($8::uuid, round_id),
+				version = version + CASE WHEN body IS DISTINCT FROM $2 THEN 1 ELSE 0 END,
+				updated_at = $7
+			WHERE id = $1 AND archived_at IS NULL
+			RETURNING id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at
+		), new_version AS (
+			INSERT INTO question_versions (question_id, version, body, created_at)
+			SELECT id, version, body, updated_at FROM updated
+			ON CONFLICT (question_id, version) DO NOTHING
+		)
+		SELECT id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at FROM updated
+	`
+
+	// ArchiveQuestionQuery hides a question from applicants while keeping its answers
+	ArchiveQuestionQuery = `
+		UPDATE questions
+		SET archived_at = $2, updated_at = $2
+		WHERE id = $1 AND archived_at IS NULL
+		RETURNING id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at
+	`
+
+	// RestoreQuestionQuery brings an archived question back, placing it at the end of its department
+	RestoreQuestionQuery = `
+		UPDATE questions q
+		SET archived_at = NULL,
+			position = (SELECT COALESCE(MAX(p.position), 0) + 1 FROM questions p WHERE p.department = q.department),
+			updated_at = $2
+		WHERE q.id = $1 AND q.archived_at IS NOT NULL
+		RETURNING id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at
+	`
+
+	// GetLiveQuestionIDsByDepartmentQuery lists the IDs of a department's live questions
+	GetLiveQuestionIDsByDepartmentQuery = `
+		SELECT id FROM questions WHERE department = $1 AND archived_at IS NULL
+	`
+
+	// ReorderQuestionsQuery sets each question's position from its index in $2
+	ReorderQuestionsQuery = `
+		UPDATE questions q
+		SET position = o.position, updated_at = $3
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, position)
+		WHERE q.id = o.id AND q.department = $1
+	`
+
+	// SetQuestionPositionQuery moves a single question
+	SetQuestionPositionQuery = `
+		UPDATE questions SET position = $2 WHERE id = $1
+	`
+
+	// GetLiveQuestionsByDepartmentsQuery fetches the live questions of the departments in $1 (all when empty) in display order
+	GetLiveQuestionsByDepartmentsQuery = `
+		SELECT id, department, domain, type, body, options, required, round_id, position, version, archived_at, created_at, updated_at
+		FROM questions
+		WHERE (cardinality($1::text[]) = 0 OR department = ANY($1::text[])) AND archived_at IS NULL
+		ORDER BY department ASC, position ASC, created_at ASC
+	`
+
+	// GetQuestionConditionsQuery fetches the visibility conditions of the questions in $1
+	GetQuestionConditionsQuery = `
+		SELECT question_id, depends_on, operator, "values"
+		FROM question_conditions
+		WHERE question_id = ANY($1::uuid[])
+		ORDER BY created_at ASC
+	`
+
+	// GetDepartmentQuestionConditionsQuery fetches every visibility condition between questions of a department
+	GetDepartmentQuestionConditionsQuery = `
+		SELECT c.question_id, c.depends_on
+		FROM question_conditions c
+		JOIN questions q ON q.id = c.question_id
+		WHERE q.department = $1
+	`
+
+	// GetQuestionDependentsQuery lists the live questions whose visibility depends on question $1
+	GetQuestionDependentsQuery = `
+		SELECT q.id
+		FROM question_conditions c
+		JOIN questions q ON q.id = c.question_id
+		WHERE c.depends_on = $1 AND q.archived_at IS NULL
+		ORDER BY q.position ASC
+	`
+
+	// DeleteQuestionConditionsQuery removes a question's visibility conditions
+	DeleteQuestionConditionsQuery = `
+		DELETE FROM question_conditions WHERE question_id = $1
+	`
+
+	// InsertQuestionConditionQuery adds a visibility condition to a question
+	InsertQuestionConditionQuery = `
+		INSERT INTO question_conditions (question_id, depends_on, operator, "values", created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	// GetQuestionVersionsQuery lists every wording a question has had, newest first
+	GetQuestionVersionsQuery = `
+		SELECT question_id, version, body, created_at
+		FROM question_versions
+		WHERE question_id = $1
+		ORDER BY version DESC
 	`
 )
+
+// QuestionSortFields are the columns questions can be sorted by
+var QuestionSortFields = map[string]SortField{
+	"created_at": {Column: "created_at", Type: "timestamptz"},
+	"department": {Column: "department::text", Type: "text"},
+	"position":   {Column: "position", Type: "integer"},
+}
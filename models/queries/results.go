@@ -0,0 +1,169 @@
+package queries
+
+// Results publication SQL queries
+
+const (
+	// departmentResultsColumns selects a staged publication and whether it has been published
+	departmentResultsColumns = `department, publish_at, response_deadline, publish_at <= now(), announced_at, staged_by, created_at, updated_at`
+
+	// applicationResultColumns selects an application result, aliased as r
+	applicationResultColumns = `
+		r.application_id, r.department, r.outcome, r.feedback, r.offer_status, r.response_deadline, r.responded_at,
+		r.waitlist_position, r.promoted_at, r.created_at, r.updated_at
+	`
+
+	// resultOutcomeStatuses are the application statuses that are staged as results
+	resultOutcomeStatuses = `('selected', 'waitlisted', 'rejected', 'released')`
+
+	// GetDepartmentResultsQuery fetches a department's staged publication
+	GetDepartmentResultsQuery = `SELECT ` + departmentResultsColumns + ` FROM department_results WHERE department = $1`
+
+	// UpsertDepartmentResultsQuery stages or re-stages a department's publication
+	UpsertDepartmentResultsQuery = `
+		INSERT INTO department_results (department, publish_at, response_deadline, staged_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (department)
+		DO UPDATE SET
+			publish_at = EXCLUDED.publish_at,
+			response_deadline = EXCLUDED.response_deadline,
+			staged_by = EXCLUDED.staged_by,
+			updated_at = EXCLUDED.updated_at
+		RETURNING ` + departmentResultsColumns
+
+	// StageApplicationResultsQuery snapshots the decided applications of a department ($1) as results.
+	// Selected applicants get a pending offer lapsing at $2; feedback and waitlist places already set are kept.
+	StageApplicationResultsQuery = `
+		INSERT INTO application_results (application_id, department, outcome, offer_status, response_deadline, created_at, updated_at)
+		SELECT id, department, status,
+			CASE WHEN status = 'selected' THEN 'pending' END,
+			CASE WHEN status = 'selected' THEN $2::timestamptz END,
+			$3, $3
+		FROM applications
+		WHERE department = $1 AND status IN ` + resultOutcomeStatuses + `
+		ON CONFLICT (application_id)
+		DO UPDATE SET
+			outcome = EXCLUDED.outcome,
+			offer_status = EXCLUDED.offer_status,
+			response_deadline = EXCLUDED.response_deadline,
+			responded_at = NULL,
+			waitlist_position = CASE WHEN EXCLUDED.outcome = 'waitlisted' THEN application_results.waitlist_position END,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	// DeleteStaleApplicationResultsQuery removes results of applications that are no longer decided
+	DeleteStaleApplicationResultsQuery = `
+		DELETE FROM application_results r
+		WHERE r.department = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM applications app
+				WHERE app.id = r.application_id AND app.department = $1 AND app.status IN ` + resultOutcomeStatuses + `
+			)
+	`
+
+	// CountUndecidedApplicationsQuery counts a department's submitted applications without a decision
+	CountUndecidedApplicationsQuery = `
+		SELECT COUNT(*)
+		FROM applications
+		WHERE department = $1 AND status = 'submitted'
+	`
+
+	// DeleteDepartmentResultsQuery withdraws a department's staged publication, with its results, before it is published
+	DeleteDepartmentResultsQuery = `DELETE FROM department_results WHERE department = $1 AND publish_at > now()`
+
+	// GetDepartmentApplicationResultsQuery lists a department's results
+	GetDepartmentApplicationResultsQuery = `
+		SELECT ` + applicationResultColumns + `
+		FROM application_results r
+		WHERE r.department = $1
+		ORDER BY r.outcome ASC, r.created_at ASC, r.application_id ASC
+	`
+
+	// SetResultFeedbackQuery sets the feedback shown with an application's result
+	SetResultFeedbackQuery = `
+		UPDATE application_results r
+		SET feedback = $2, updated_at = $3
+		WHERE r.application_id = $1
+		RETURNING ` + applicationResultColumns
+
+	// SetDepartmentResultFeedbackQuery sets the feedback of a result, provided it belongs to department $4
+	SetDepartmentResultFeedbackQuery = `
+		UPDATE application_results
+		SET feedback = $2, updated_at = $3
+		WHERE application_id = $1 AND department = $4
+	`
+
+	// GetUserResultsQuery lists a user's submitted applications with whether their department's results are
+	// published and, if so, the result
+	GetUserResultsQuery = `
+		SELECT app.id, app.department, COALESCE(dr.publish_at <= now(), false),
+			r.application_id IS NOT NULL AND COALESCE(dr.publish_at <= now(), false),
+			r.outcome, r.feedback, r.offer_status, r.response_deadline, r.responded_at, r.waitlist_position, r.promoted_at,
+			r.created_at, r.updated_at
+		FROM applications app
+		LEFT JOIN department_results dr ON dr.department = app.department
+		LEFT JOIN application_results r ON r.application_id = app.id
+		WHERE app.user_id = $1 AND app.status <> 'draft'
+		ORDER BY app.preference ASC NULLS LAST, app.created_at ASC
+	`
+
+	// GetOfferForUpdateQuery locks an application's result, with its owner and whether it has been published
+	GetOfferForUpdateQuery = `
+		SELECT ` + applicationResultColumns + `, app.user_id, dr.publish_at <= now()
+		FROM application_results r
+		INNER JOIN applications app ON app.id = r.application_id
+		INNER JOIN department_results dr ON dr.department = r.department
+		WHERE r.application_id = $1
+		FOR UPDATE OF r
+	`
+
+	// RespondToOfferQuery records the answer to a pending offer
+	RespondToOfferQuery = `
+		UPDATE application_results r
+		SET offer_status = $2, responded_at = $3, updated_at = $3
+		WHERE r.application_id = $1 AND r.offer_status = 'pending'
+		RETURNING ` + applicationResultColumns
+
+	// ReleaseOfferQuery declines the pending or accepted offer of an application being withdrawn, freeing its seat
+	ReleaseOfferQuery = `
+		UPDATE application_results
+		SET offer_status = 'declined', responded_at = $2, updated_at = $2
+		WHERE application_id = $1 AND offer_status IN ('pending', 'accepted')
+	`
+
+	// GetUnpublishedDepartmentsQuery lists the departments whose results are not published, staged or not
+	GetUnpublishedDepartmentsQuery = `
+		SELECT d.slug
+		FROM departments d
+		WHERE NOT EXISTS (SELECT 1 FROM department_results dr WHERE dr.department = d.slug AND dr.publish_at <= now())
+	`
+
+	// DepartmentResultsPublishedQuery reports whether a department's results are published
+	DepartmentResultsPublishedQuery = `SELECT EXISTS(SELECT 1 FROM department_results WHERE department = $1 AND publish_at <= now())`
+
+	// AnnounceDueResultsQuery marks the publications that have come due as announced, returning their departments
+	AnnounceDueResultsQuery = `
+		UPDATE department_results
+		SET announced_at = $1
+		WHERE publish_at <= $1 AND announced_at IS NULL
+		RETURNING department
+	`
+
+	// ClaimResultsEmailsQuery clears the email_on_results flag of department $1's applications that have a result,
+	// returning the addresses of the applicants waiting to be emailed
+	ClaimResultsEmailsQuery = `
+		UPDATE applications app
+		SET email_on_results = false
+		FROM application_results r, users u
+		WHERE r.application_id = app.id AND r.department = $1 AND app.email_on_results AND u.id = app.user_id
+		RETURNING u.email
+	`
+
+	// GetPublishedResultApplicationsQuery lists the results of a department together with their applications
+	GetPublishedResultApplicationsQuery = `
+		SELECT app.id, app.user_id, app.department, app.submitted, app.status, app.preference, app.created_at, app.updated_at,
+			` + applicationResultColumns + `
+		FROM application_results r
+		INNER JOIN applications app ON app.id = r.application_id
+		WHERE r.department = $1
+	`
+)
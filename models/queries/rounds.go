@@ -0,0 +1,87 @@
+package queries
+
+// Round-related SQL queries
+
+const (
+	// GetDepartmentRoundsQuery lists a department's rounds in order
+	GetDepartmentRoundsQuery = `
+		SELECT id, department, name, description, position, created_at, updated_at
+		FROM rounds
+		WHERE department = $1
+		ORDER BY position ASC, created_at ASC
+	`
+
+	// GetRoundByIDQuery fetches a single round
+	GetRoundByIDQuery = `
+		SELECT id, department, name, description, position, created_at, updated_at
+		FROM rounds
+		WHERE id = $1
+	`
+
+	// GetPreviousRoundQuery fetches the round before $1 in its department
+	GetPreviousRoundQuery = `
+		SELECT p.id, p.department, p.name, p.description, p.position, p.created_at, p.updated_at
+		FROM rounds r
+		JOIN rounds p ON p.department = r.department AND (p.position, p.created_at) < (r.position, r.created_at)
+		WHERE r.id = $1
+		ORDER BY p.position DESC, p.created_at DESC
+		LIMIT 1
+	`
+
+	// CreateRoundQuery adds a round at the end of a department's pipeline
+	CreateRoundQuery = `
+		INSERT INTO rounds (id, department, name, description, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, (SELECT COALESCE(MAX(position), 0) + 1 FROM rounds WHERE department = $2), $5, $5)
+		RETURNING id, department, name, description, position, created_at, updated_at
+	`
+
+	// UpdateRoundQuery renames a round
+	UpdateRoundQuery = `
+		UPDATE rounds
+		SET name = $2, description = $3, updated_at = $4
+		WHERE id = $1
+		RETURNING id, department, name, description, position, created_at, updated_at
+	`
+
+	// DeleteRoundQuery removes a round; it fails while questions or admissions reference it
+	DeleteRoundQuery = `
+		DELETE FROM rounds WHERE id = $1
+	`
+
+	// AdmitApplicationToFirstRoundQuery admits a new application ($1) to the first round of its department ($2)
+	AdmitApplicationToFirstRoundQuery = `
+		INSERT INTO application_rounds (application_id, round_id, admitted_at)
+		SELECT $1, id, $3
+		FROM rounds
+		WHERE department = $2
+		ORDER BY position ASC, created_at ASC
+		LIMIT 1
+	`
+
+	// GetAdvanceCandidatesQuery fetches the applications in $2 with whether each has been admitted to round $1
+	// and to the round before it ($3)
+	GetAdvanceCandidatesQuery = `
+		SELECT app.id, app.user_id, app.department, app.submitted, app.status, app.preference, app.created_at, app.updated_at,
+			EXISTS(SELECT 1 FROM application_rounds ar WHERE ar.application_id = app.id AND ar.round_id = $3),
+			EXISTS(SELECT 1 FROM application_rounds ar WHERE ar.application_id = app.id AND ar.round_id = $1)
+		FROM applications app
+		WHERE app.id = ANY($2::uuid[])
+	`
+
+	// AdmitApplicationsToRoundQuery admits the applications in $2 to round $1
+	AdmitApplicationsToRoundQuery = `
+		INSERT INTO application_rounds (application_id, round_id, admitted_by, admitted_at)
+		SELECT unnest($2::uuid[]), $1, $3, $4
+		ON CONFLICT (application_id, round_id) DO NOTHING
+	`
+
+	// GetUserApplicationRoundsQuery lists the rounds each of a user's applications has been admitted to
+	GetUserApplicationRoundsQuery = `
+		SELECT ar.application_id, r.id, r.name, r.description, r.position, ar.admitted_at
+		FROM application_rounds ar
+		JOIN rounds r ON r.id = ar.round_id
+		JOIN applications app ON app.id = ar.application_id
+		WHERE app.user_id = $1
+		ORDER BY r.position ASC, r.created_at ASC
+	`
+)
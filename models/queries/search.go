@@ -0,0 +1,69 @@
+package queries
+
+// Search and evaluator department access SQL queries
+
+const (
+	// SearchAnswersQuery ranks submitted answers matching a web-search style query ($1).
+	// $2 is the requesting evaluator (NULL for admins); evaluators with department rows only see those departments.
+	// $3 optionally filters by department and $4 limits the result count.
+	// Each row reports whether the application is under blind review. Matches in the snippet are wrapped in the
+	// private-use characters U+E000 and U+E001 (stripped from the answer first), see models.HighlightSnippet.
+	SearchAnswersQuery = `
+		SELECT a.id, a.application_id, application_blind_review(app.id), u.id, u.full_name, u.reg_num, q.id, q.body, q.department,
+			ts_headline('english', translate(a.body, chr(57344) || chr(57345), ''), query,
+				'StartSel=' || chr(57344) || ', StopSel=' || chr(57345) ||
+				', MaxFragments=2, MaxWords=30, MinWords=10, FragmentDelimiter=" … "'),
+			ts_rank_cd(a.body_tsv, query) AS rank
+		FROM answers a
+		JOIN applications app ON app.id = a.application_id
+		JOIN questions q ON q.id = a.question_id
+		JOIN users u ON u.id = a.user_id,
+			websearch_to_tsquery('english', $1) query
+		WHERE a.body_tsv @@ query
+			AND app.status NOT IN ('draft', 'withdrawn')
+			AND ($3 = '' OR q.department::text = $3)
+			AND evaluator_reviews_department($2::uuid, q.department)
+		ORDER BY rank DESC, a.updated_at DESC
+		LIMIT $4
+	`
+
+	// EvaluatorReviewsDepartmentQuery reports whether evaluator $1 may review department $2
+	EvaluatorReviewsDepartmentQuery = `
+		SELECT evaluator_reviews_department($1, $2)
+	`
+
+	// EvaluatorReviewsApplicationQuery reports whether evaluator $1 may review application $2's department
+	EvaluatorReviewsApplicationQuery = `
+		SELECT evaluator_reviews_department($1, department)
+		FROM applications
+		WHERE id = $2
+	`
+
+	// GetApplicationDepartmentQuery returns the department of application $1
+	GetApplicationDepartmentQuery = `
+		SELECT department
+		FROM applications
+		WHERE id = $1
+	`
+
+	// GetEvaluatorDepartmentsQuery lists the departments an evaluator is restricted to
+	GetEvaluatorDepartmentsQuery = `
+		SELECT department
+		FROM evaluator_departments
+		WHERE user_id = $1
+		ORDER BY department
+	`
+
+	// DeleteEvaluatorDepartmentsQuery clears an evaluator's department restrictions
+	DeleteEvaluatorDepartmentsQuery = `
+		DELETE FROM evaluator_departments
+		WHERE user_id = $1
+	`
+
+	// InsertEvaluatorDepartmentsQuery restricts an evaluator to a set of departments
+	InsertEvaluatorDepartmentsQuery = `
+		INSERT INTO evaluator_departments (user_id, department)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT DO NOTHING
+	`
+)
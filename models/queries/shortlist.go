@@ -0,0 +1,28 @@
+package queries
+
+// Shortlist SQL queries
+
+const (
+	// GetShortlistCandidatesQuery lists a department's applications still in contention with their evaluation count
+	// and mean score. Withdrawn applications and applicants who chickened out are left out.
+	GetShortlistCandidatesQuery = `
+		SELECT app.id, app.user_id, u.email, app.status, app.preference, app.submitted_at,
+			COUNT(e.id)::int, COALESCE(AVG(e.score), 0)::float8
+		FROM applications app
+		INNER JOIN users u ON u.id = app.user_id
+		LEFT JOIN evaluations e ON e.application_id = app.id
+		WHERE app.department = $1
+			AND app.status IN ('submitted', 'selected', 'waitlisted')
+			AND NOT u.chickened_out
+		GROUP BY app.id, u.email
+	`
+
+	// SetShortlistStatusQuery moves an application to its shortlist outcome, provided its status ($4) is unchanged.
+	// With $5 the applicant is emailed once the department's results are published.
+	SetShortlistStatusQuery = `
+		UPDATE applications
+		SET status = $2, updated_at = $3, email_on_results = email_on_results OR $5
+		WHERE id = $1 AND status = $4
+		RETURNING id, user_id, department, submitted, status, preference, created_at, updated_at
+	`
+)
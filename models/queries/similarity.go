@@ -0,0 +1,67 @@
+package queries
+
+// Answer similarity SQL queries
+
+const (
+	// TryAdvisoryLockQuery takes a session-level advisory lock without waiting
+	TryAdvisoryLockQuery = `SELECT pg_try_advisory_lock($1)`
+
+	// AdvisoryUnlockQuery releases a session-level advisory lock
+	AdvisoryUnlockQuery = `SELECT pg_advisory_unlock($1)`
+
+	// GetSimilarityScanQuestionsQuery lists the questions whose answers are compared
+	GetSimilarityScanQuestionsQuery = `
+		SELECT id
+		FROM questions
+	`
+
+	// GetSimilarityScanAnswersQuery fetches submitted answers to a question
+	GetSimilarityScanAnswersQuery = `
+		SELECT a.id, a.user_id, a.body
+		FROM answers a
+		JOIN applications app ON app.id = a.application_id
+		WHERE a.question_id = $1 AND app.status NOT IN ('draft', 'withdrawn')
+	`
+
+	// UpsertSimilarityFlagQuery records a similar pair, refreshing its score and check time if it already exists
+	UpsertSimilarityFlagQuery = `
+		INSERT INTO similarity_flags (question_id, answer_a_id, answer_b_id, similarity, checked_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (answer_a_id, answer_b_id)
+		DO UPDATE SET
+			similarity = EXCLUDED.similarity,
+			checked_at = EXCLUDED.checked_at
+	`
+
+	// DeleteStaleSimilarityFlagsQuery removes pairs of a question that were not confirmed by the latest scan
+	DeleteStaleSimilarityFlagsQuery = `
+		DELETE FROM similarity_flags
+		WHERE question_id = $1 AND checked_at < $2
+	`
+
+	// similarityFlagSelect joins both answers of a flag with their applicants and whether each is under blind review
+	similarityFlagSelect = `
+		SELECT f.id, f.question_id, q.body, q.department, f.similarity, f.checked_at, f.created_at,
+			aa.id, aa.application_id, application_blind_review(aa.application_id), ua.id, ua.full_name, ua.reg_num, aa.body,
+			ab.id, ab.application_id, application_blind_review(ab.application_id), ub.id, ub.full_name, ub.reg_num, ab.body
+		FROM similarity_flags f
+		JOIN questions q ON q.id = f.question_id
+		JOIN answers aa ON aa.id = f.answer_a_id
+		JOIN users ua ON ua.id = aa.user_id
+		JOIN answers ab ON ab.id = f.answer_b_id
+		JOIN users ub ON ub.id = ab.user_id
+	`
+
+	// GetSimilarityFlagsQuery lists flags at or above a minimum similarity ($1), optionally for one department ($2), limited to $3
+	GetSimilarityFlagsQuery = similarityFlagSelect + `
+		WHERE f.similarity >= $1 AND ($2 = '' OR q.department::text = $2)
+		ORDER BY f.similarity DESC, f.created_at DESC
+		LIMIT $3
+	`
+
+	// GetApplicationSimilarityFlagsQuery lists flags involving any answer of an application
+	GetApplicationSimilarityFlagsQuery = similarityFlagSelect + `
+		WHERE aa.application_id = $1 OR ab.application_id = $1
+		ORDER BY f.similarity DESC
+	`
+)
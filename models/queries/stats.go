@@ -0,0 +1,93 @@
+package queries
+
+// Reporting SQL queries
+
+const (
+	// GetUserStatsQuery aggregates applicant registration counts
+	GetUserStatsQuery = `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE u.verified),
+			COUNT(*) FILTER (WHERE u.chickened_out),
+			COUNT(*) FILTER (WHERE NOT EXISTS (SELECT 1 FROM applications app WHERE app.user_id = u.id))
+		FROM users u
+		WHERE u.role = 'applicant'
+	`
+
+	// GetDepartmentStatsQuery aggregates the application funnel per department
+	GetDepartmentStatsQuery = `
+		SELECT
+			app.department::text,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE app.submitted),
+			COUNT(*) FILTER (WHERE app.submitted AND EXISTS (SELECT 1 FROM evaluations e WHERE e.application_id = app.id)),
+			COUNT(*) FILTER (WHERE u.chickened_out),
+			COALESCE(AVG(COALESCE(ac.answer_count, 0)), 0)::float8
+		FROM applications app
+		INNER JOIN users u ON u.id = app.user_id
+		LEFT JOIN (
+			SELECT application_id, COUNT(*) AS answer_count
+			FROM answers
+			GROUP BY application_id
+		) ac ON ac.application_id = app.id
+		GROUP BY app.department
+		ORDER BY app.department
+	`
+
+	// GetEvaluationStatsQuery aggregates evaluation activity
+	GetEvaluationStatsQuery = `
+		SELECT
+			(SELECT COUNT(*) FROM evaluations),
+			(SELECT COUNT(DISTINCT evaluator_id) FROM evaluations),
+			(SELECT COALESCE(AVG(score), 0)::float8 FROM evaluations),
+			(SELECT COUNT(*) FROM applications app
+				WHERE app.status = 'submitted' AND NOT EXISTS (SELECT 1 FROM evaluations e WHERE e.application_id = app.id))
+	`
+
+	// GetDailyStatsQuery builds a per-day time series over the last $1 days
+	GetDailyStatsQuery = `
+		WITH days AS (
+			SELECT generate_series(
+				date_trunc('day', NOW()) - ($1::int - 1) * INTERVAL '1 day',
+				date_trunc('day', NOW()),
+				INTERVAL '1 day'
+			) AS day
+		),
+		registrations AS (
+			SELECT date_trunc('day', created_at) AS day, COUNT(*) AS n
+			FROM users
+			WHERE role = 'applicant' AND created_at >= (SELECT MIN(day) FROM days)
+			GROUP BY 1
+		),
+		created AS (
+			SELECT date_trunc('day', created_at) AS day, COUNT(*) AS n
+			FROM applications
+			WHERE created_at >= (SELECT MIN(day) FROM days)
+			GROUP BY 1
+		),
+		submitted AS (
+			SELECT date_trunc('day', submitted_at) AS day, COUNT(*) AS n
+			FROM applications
+			WHERE submitted_at >= (SELECT MIN(day) FROM days)
+			GROUP BY 1
+		),
+		evaluated AS (
+			SELECT date_trunc('day', created_at) AS day, COUNT(*) AS n
+			FROM evaluations
+			WHERE created_at >= (SELECT MIN(day) FROM days)
+			GROUP BY 1
+		)
+		SELECT
+			to_char(d.day, 'YYYY-MM-DD'),
+			COALESCE(r.n, 0),
+			COALESCE(c.n, 0),
+			COALESCE(s.n, 0),
+			COALESCE(e.n, 0)
+		FROM days d
+		LEFT JOIN registrations r ON r.day = d.day
+		LEFT JOIN created c ON c.day = d.day
+		LEFT JOIN submitted s ON s.day = d.day
+		LEFT JOIN evaluated e ON e.day = d.day
+		ORDER BY d.day ASC
+	`
+)
@@ -0,0 +1,131 @@
+package queries
+
+// Task-related SQL queries
+
+const (
+	// taskColumns selects a task with its round's department; used with tasks aliased as t and rounds as r
+	taskColumns = `
+		t.id, t.round_id, r.department, t.title, t.description, t.attachments, t.deadline, t.late_window_minutes,
+		t.created_by, t.created_at, t.updated_at
+	`
+
+	// taskSubmissionColumns selects a task submission
+	taskSubmissionColumns = `
+		s.id, s.task_id, s.application_id, s.user_id, s.repository_url, s.deploy_url, s.notes, s.late, s.submitted_at, s.updated_at
+	`
+
+	// GetRoundTasksQuery lists the tasks of a round by deadline
+	GetRoundTasksQuery = `SELECT ` + taskColumns + ` FROM tasks t JOIN rounds r ON r.id = t.round_id WHERE t.round_id = $1 ORDER BY t.deadline ASC, t.created_at ASC`
+
+	// GetTaskByIDQuery fetches a single task
+	GetTaskByIDQuery = `SELECT ` + taskColumns + ` FROM tasks t JOIN rounds r ON r.id = t.round_id WHERE t.id = $1`
+
+	// CreateTaskQuery adds a task to a round
+	CreateTaskQuery = `
+		WITH t AS (
+			INSERT INTO tasks (id, round_id, title, description, attachments, deadline, late_window_minutes, created_by, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+			RETURNING *
+		)
+		SELECT ` + taskColumns + ` FROM t JOIN rounds r ON r.id = t.round_id
+	`
+
+	// UpdateTaskQuery replaces a task's details
+	UpdateTaskQuery = `
+		WITH t AS (
+			UPDATE tasks
+			SET title = $2, description = $3, attachments = $4, deadline = $5, late_window_minutes = $6, updated_at = $7
+			WHERE id = $1
+			RETURNING *
+		)
+		SELECT ` + taskColumns + ` FROM t JOIN rounds r ON r.id = t.round_id
+	`
+
+	// DeleteTaskQuery removes a task; it fails once applicants have submitted
+	DeleteTaskQuery = `DELETE FROM tasks WHERE id = $1`
+
+	// UpsertTaskExtensionQuery grants or replaces an application's extension on a task
+	UpsertTaskExtensionQuery = `
+		INSERT INTO task_extensions (task_id, application_id, deadline, reason, granted_by, granted_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (task_id, application_id)
+		DO UPDATE SET deadline = EXCLUDED.deadline, reason = EXCLUDED.reason, granted_by = EXCLUDED.granted_by, granted_at = EXCLUDED.granted_at
+		RETURNING task_id, application_id, deadline, reason, granted_by, granted_at
+	`
+
+	// DeleteTaskExtensionQuery revokes an application's extension on a task
+	DeleteTaskExtensionQuery = `DELETE FROM task_extensions WHERE task_id = $1 AND application_id = $2`
+
+	// GetTaskApplicationWindowQuery fetches what decides whether application $2 may submit task $1:
+	// its owner and status, whether it was admitted to the task's round, and its deadline including any extension
+	GetTaskApplicationWindowQuery = `
+		SELECT app.user_id, app.status, r.department = app.department,
+			EXISTS(SELECT 1 FROM application_rounds ar WHERE ar.application_id = app.id AND ar.round_id = t.round_id),
+			COALESCE(e.deadline, t.deadline), t.late_window_minutes
+		FROM tasks t
+		JOIN rounds r ON r.id = t.round_id
+		JOIN applications app ON app.id = $2
+		LEFT JOIN task_extensions e ON e.task_id = t.id AND e.application_id = app.id
+		WHERE t.id = $1
+	`
+
+	// UpsertTaskSubmissionQuery records or replaces an application's submission for a task
+	UpsertTaskSubmissionQuery = `
+		INSERT INTO task_submissions AS s (id, task_id, application_id, user_id, repository_url, deploy_url, notes, late, submitted_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+		ON CONFLICT (task_id, application_id)
+		DO UPDATE SET
+			repository_url = EXCLUDED.repository_url,
+			deploy_url = EXCLUDED.deploy_url,
+			notes = EXCLUDED.notes,
+			late = EXCLUDED.late,
+			updated_at = EXCLUDED.updated_at
+		RETURNING ` + taskSubmissionColumns
+
+	// GetUserTasksQuery lists the tasks of every round a user's applications have been admitted to,
+	// with the application and its deadline including any extension
+	GetUserTasksQuery = `
+		SELECT ` + taskColumns + `, app.id, COALESCE(e.deadline, t.deadline)
+		FROM tasks t
+		JOIN rounds r ON r.id = t.round_id
+		JOIN application_rounds ar ON ar.round_id = t.round_id
+		JOIN applications app ON app.id = ar.application_id
+		LEFT JOIN task_extensions e ON e.task_id = t.id AND e.application_id = app.id
+		WHERE app.user_id = $1
+		ORDER BY COALESCE(e.deadline, t.deadline) ASC, t.created_at ASC
+	`
+
+	// GetUserTaskSubmissionsQuery lists a user's task submissions
+	GetUserTaskSubmissionsQuery = `SELECT ` + taskSubmissionColumns + ` FROM task_submissions s WHERE s.user_id = $1`
+
+	// GetTaskSubmissionsQuery lists the submissions for a task, oldest first, with whether each is under blind review
+	GetTaskSubmissionsQuery = `
+		SELECT ` + taskSubmissionColumns + `, application_blind_review(s.application_id)
+		FROM task_submissions s
+		WHERE s.task_id = $1
+		ORDER BY s.submitted_at ASC
+	`
+
+	// GetTaskSubmissionByIDQuery fetches a single task submission
+	GetTaskSubmissionByIDQuery = `SELECT ` + taskSubmissionColumns + ` FROM task_submissions s WHERE s.id = $1`
+
+	// GetTaskGradesQuery lists the grades of every submission for a task
+	GetTaskGradesQuery = `
+		SELECT g.id, g.submission_id, g.evaluator_id, g.score, g.notes, g.created_at, g.updated_at
+		FROM task_grades g
+		JOIN task_submissions s ON s.id = g.submission_id
+		WHERE s.task_id = $1
+		ORDER BY g.created_at ASC
+	`
+
+	// UpsertTaskGradeQuery records or updates an evaluator's grade for a submission
+	UpsertTaskGradeQuery = `
+		INSERT INTO task_grades (submission_id, evaluator_id, score, notes)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (submission_id, evaluator_id)
+		DO UPDATE SET
+			score = EXCLUDED.score,
+			notes = EXCLUDED.notes
+		RETURNING id, submission_id, evaluator_id, score, notes, created_at, updated_at
+	`
+)
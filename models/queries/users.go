@@ -10,12 +10,9 @@ const (
 		RETURNING id, full_name, email, reg_num, phone_number, verified, role, chickened_out, created_at, updated_at
 	`
 
-	// GetAllUsersQuery retrieves all users from the database
-	GetAllUsersQuery = `
-		SELECT id, full_name, email, reg_num, phone_number, verified, role, chickened_out, created_at, updated_at
-		FROM users
-		ORDER BY created_at DESC
-	`
+	// ListUsersColumns and ListUsersFrom are the base of the paginated user listing; filters are added with a ListQuery
+	ListUsersColumns = `id, full_name, email, reg_num, phone_number, verified, role, chickened_out, created_at, updated_at`
+	ListUsersFrom    = `users`
 
 	// GetUserByIDQuery retrieves a single user by their ID
 	GetUserByIDQuery = `
@@ -83,3 +80,12 @@ const (
 		WHERE id = $1
 	`
 )
+
+// UserSortFields are the columns users can be sorted by
+var UserSortFields = map[string]SortField{
+	"created_at": {Column: "created_at", Type: "timestamptz"},
+	"updated_at": {Column: "updated_at", Type: "timestamptz"},
+	"full_name":  {Column: "full_name", Type: "text"},
+	"email":      {Column: "email", Type: "text"},
+	"reg_num":    {Column: "reg_num", Type: "text"},
+}
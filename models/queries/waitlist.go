@@ -0,0 +1,108 @@
+package queries
+
+// Waitlist SQL queries
+
+const (
+	// RenumberWaitlistQuery numbers a department's waitlist from 1 without gaps. Results keep their relative order;
+	// newly waitlisted ones join the end by mean evaluation score.
+	RenumberWaitlistQuery = `
+		UPDATE application_results r
+		SET waitlist_position = ranked.position
+		FROM (
+			SELECT w.application_id,
+				ROW_NUMBER() OVER (
+					ORDER BY w.waitlist_position ASC NULLS LAST,
+						(SELECT AVG(e.score) FROM evaluations e WHERE e.application_id = w.application_id) DESC NULLS LAST,
+						w.created_at ASC, w.application_id ASC
+				) AS position
+			FROM application_results w
+			WHERE w.department = $1 AND w.outcome = 'waitlisted'
+		) ranked
+		WHERE r.application_id = ranked.application_id AND r.waitlist_position IS DISTINCT FROM ranked.position
+	`
+
+	// GetWaitlistQuery lists a department's waitlist in order with the applicants
+	GetWaitlistQuery = `
+		SELECT ` + applicationResultColumns + `, app.status, u.full_name, u.email
+		FROM application_results r
+		INNER JOIN applications app ON app.id = r.application_id
+		INNER JOIN users u ON u.id = app.user_id
+		WHERE r.department = $1 AND r.outcome = 'waitlisted'
+		ORDER BY r.waitlist_position ASC NULLS LAST, r.application_id ASC
+	`
+
+	// ReorderWaitlistQuery places the department's ($1) waitlisted results in the order of the IDs in $2
+	ReorderWaitlistQuery = `
+		UPDATE application_results r
+		SET waitlist_position = o.position, updated_at = $3
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(application_id, position)
+		WHERE r.application_id = o.application_id AND r.department = $1 AND r.outcome = 'waitlisted'
+	`
+
+	// GetWaitlistSeatsQuery counts a department's seats: its capacity, or the offers made at publication without one,
+	// against the offers accepted or awaiting an answer, and the length of its waitlist
+	GetWaitlistSeatsQuery = `
+		SELECT COALESCE(d.capacity, COUNT(r.application_id) FILTER (WHERE r.outcome = 'selected' AND r.promoted_at IS NULL))::int,
+			COUNT(r.application_id) FILTER (WHERE r.offer_status = 'accepted')::int,
+			COUNT(r.application_id) FILTER (WHERE r.offer_status = 'pending')::int,
+			COUNT(r.application_id) FILTER (WHERE r.outcome = 'waitlisted')::int
+		FROM departments d
+		LEFT JOIN application_results r ON r.department = d.slug
+		WHERE d.slug = $1
+		GROUP BY d.capacity
+	`
+
+	// LockPublishedResultsQuery locks a department's published results, returning their response deadline
+	LockPublishedResultsQuery = `
+		SELECT response_deadline
+		FROM department_results
+		WHERE department = $1 AND publish_at <= now()
+		FOR UPDATE
+	`
+
+	// PromoteWaitlistQuery offers up to $2 freed seats of department $1 to the front of its waitlist, skipping
+	// applications no longer waitlisted. The offers lapse at $3. Returns the results and the applicants' emails.
+	PromoteWaitlistQuery = `
+		WITH next AS (
+			SELECT w.application_id
+			FROM application_results w
+			INNER JOIN applications app ON app.id = w.application_id
+			WHERE w.department = $1 AND w.outcome = 'waitlisted' AND app.status = 'waitlisted'
+			ORDER BY w.waitlist_position ASC NULLS LAST, w.application_id ASC
+			LIMIT $2
+			FOR UPDATE OF w
+		)
+		UPDATE application_results r
+		SET outcome = 'selected', offer_status = 'pending', response_deadline = $3, responded_at = NULL,
+			waitlist_position = NULL, promoted_at = $4, updated_at = $4
+		FROM next
+		INNER JOIN applications app ON app.id = next.application_id
+		INNER JOIN users u ON u.id = app.user_id
+		WHERE r.application_id = next.application_id
+		RETURNING ` + applicationResultColumns + `, u.email
+	`
+
+	// ExpireOverdueOffersQuery lapses pending offers whose response deadline passed before $1 and releases their
+	// applications, returning the released applications
+	ExpireOverdueOffersQuery = `
+		WITH expired AS (
+			UPDATE application_results
+			SET offer_status = 'expired', updated_at = $1
+			WHERE offer_status = 'pending' AND response_deadline < $1
+			RETURNING application_id
+		)
+		UPDATE applications app
+		SET status = 'released', updated_at = $1
+		FROM expired
+		WHERE app.id = expired.application_id AND app.status = 'selected'
+		RETURNING app.id, app.user_id, app.department, app.submitted, app.status, app.preference, app.created_at, app.updated_at
+	`
+
+	// GetPromotableDepartmentsQuery lists the departments with published results and a waitlist
+	GetPromotableDepartmentsQuery = `
+		SELECT DISTINCT r.department
+		FROM application_results r
+		INNER JOIN department_results dr ON dr.department = r.department
+		WHERE r.outcome = 'waitlisted' AND dr.publish_at <= now()
+	`
+)
@@ -0,0 +1,91 @@
+package queries
+
+// Webhook-related SQL queries
+
+const (
+	// CreateWebhookQuery inserts a new webhook subscription
+	CreateWebhookQuery = `
+		INSERT INTO webhooks (url, secret, event_types, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, url, secret, event_types, active, created_by, created_at, updated_at
+	`
+
+	// GetAllWebhooksQuery retrieves all webhook subscriptions
+	GetAllWebhooksQuery = `
+		SELECT id, url, secret, event_types, active, created_by, created_at, updated_at
+		FROM webhooks
+		ORDER BY created_at DESC
+	`
+
+	// GetWebhookByIDQuery retrieves a single webhook subscription by ID
+	GetWebhookByIDQuery = `
+		SELECT id, url, secret, event_types, active, created_by, created_at, updated_at
+		FROM webhooks
+		WHERE id = $1
+	`
+
+	// GetActiveWebhooksForEventQuery retrieves active webhooks subscribed to an event type
+	GetActiveWebhooksForEventQuery = `
+		SELECT id, url, secret, event_types, active, created_by, created_at, updated_at
+		FROM webhooks
+		WHERE active = true AND $1 = ANY(event_types)
+	`
+
+	// UpdateWebhookQuery updates a webhook subscription
+	UpdateWebhookQuery = `
+		UPDATE webhooks
+		SET url = $2, event_types = $3, active = $4
+		WHERE id = $1
+		RETURNING id, url, secret, event_types, active, created_by, created_at, updated_at
+	`
+
+	// DeleteWebhookQuery deletes a webhook subscription by ID
+	DeleteWebhookQuery = `
+		DELETE FROM webhooks
+		WHERE id = $1
+	`
+
+	// CreateWebhookDeliveryQuery records a delivery attempt, with when it is retried if it failed
+	CreateWebhookDeliveryQuery = `
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, attempt, success, status_code, error, duration_ms, next_attempt_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, webhook_id, event_id, event_type, payload, attempt, success, status_code, error, duration_ms, next_attempt_at, created_at
+	`
+
+	// GetWebhookDeliveriesQuery retrieves the most recent delivery attempts for a webhook
+	GetWebhookDeliveriesQuery = `
+		SELECT id, webhook_id, event_id, event_type, payload, attempt, success, status_code, error, duration_ms, next_attempt_at, created_at
+		FROM webhook_deliveries
+		WHERE webhook_id = $1
+		ORDER BY created_at DESC
+		LIMIT $2
+	`
+
+	// ClaimDueWebhookRetriesQuery takes up to $2 failed attempts whose retry is due at $1 and leases them until $3,
+	// so no other replica retries them in the meantime. A lease is released by ReleaseWebhookRetryQuery once the retry
+	// is recorded; if it lapses first, the retry comes due again. Retries to inactive webhooks are dropped.
+	// Returns each attempt with its webhook.
+	ClaimDueWebhookRetriesQuery = `
+		WITH due AS (
+			SELECT id
+			FROM webhook_deliveries
+			WHERE next_attempt_at <= $1
+			ORDER BY next_attempt_at ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = CASE WHEN w.active THEN $3::timestamptz END
+		FROM due, webhooks w
+		WHERE d.id = due.id AND w.id = d.webhook_id
+		RETURNING d.id, d.event_id, d.event_type, d.payload, d.attempt,
+			w.id, w.url, w.secret, w.event_types, w.active, w.created_by, w.created_at, w.updated_at
+	`
+
+	// ReleaseWebhookRetryQuery clears the lease of a retried attempt once the retry has been recorded
+	ReleaseWebhookRetryQuery = `
+		UPDATE webhook_deliveries
+		SET next_attempt_at = NULL
+		WHERE id = $1
+	`
+)
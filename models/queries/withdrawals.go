@@ -0,0 +1,63 @@
+package queries
+
+// Application withdrawal SQL queries
+
+const (
+	// CreateWithdrawalQuery records a withdrawal that can be undone until reinstatable_until
+	CreateWithdrawalQuery = `
+		INSERT INTO application_withdrawals (application_id, user_id, reason, previous_status, withdrawn_at, reinstatable_until)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, application_id, user_id, reason, previous_status, withdrawn_at, reinstatable_until, reinstated_at
+	`
+
+	// GetOpenWithdrawalQuery fetches the withdrawal of an application that has not been reinstated
+	GetOpenWithdrawalQuery = `
+		SELECT id, application_id, user_id, reason, previous_status, withdrawn_at, reinstatable_until, reinstated_at
+		FROM application_withdrawals
+		WHERE application_id = $1 AND reinstated_at IS NULL
+		FOR UPDATE
+	`
+
+	// ReinstateWithdrawalQuery closes a withdrawal
+	ReinstateWithdrawalQuery = `
+		UPDATE application_withdrawals
+		SET reinstated_at = $2
+		WHERE id = $1
+		RETURNING id, application_id, user_id, reason, previous_status, withdrawn_at, reinstatable_until, reinstated_at
+	`
+
+	// GetWithdrawalTotalsQuery counts all withdrawals and those later reinstated
+	GetWithdrawalTotalsQuery = `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE reinstated_at IS NOT NULL)
+		FROM application_withdrawals
+	`
+
+	// GetWithdrawalsByDepartmentQuery counts withdrawals per department
+	GetWithdrawalsByDepartmentQuery = `
+		SELECT app.department::text, COUNT(*)
+		FROM application_withdrawals w
+		INNER JOIN applications app ON app.id = w.application_id
+		GROUP BY app.department
+		ORDER BY COUNT(*) DESC
+	`
+
+	// GetWithdrawalsByReasonQuery counts withdrawals per normalised reason, most common first
+	GetWithdrawalsByReasonQuery = `
+		SELECT COALESCE(NULLIF(LOWER(TRIM(reason)), ''), '(no reason given)') AS reason_key, COUNT(*)
+		FROM application_withdrawals
+		GROUP BY reason_key
+		ORDER BY COUNT(*) DESC, reason_key
+		LIMIT $1
+	`
+
+	// GetRecentWithdrawalsQuery lists the latest withdrawals with their applicants
+	GetRecentWithdrawalsQuery = `
+		SELECT w.id, w.application_id, w.user_id, w.reason, w.previous_status, w.withdrawn_at, w.reinstatable_until, w.reinstated_at,
+			app.department::text, u.full_name, u.reg_num
+		FROM application_withdrawals w
+		INNER JOIN applications app ON app.id = w.application_id
+		INNER JOIN users u ON u.id = w.user_id
+		ORDER BY w.withdrawn_at DESC
+		LIMIT $1
+	`
+)
@@ -1,30 +1,272 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
 
-// Department represents the possible departments in the system
-type Department string
+// Question types decide how answers are entered and validated
+const (
+	QuestionTypeShortText    = "short_text"
+	QuestionTypeLongText     = "long_text"
+	QuestionTypeURL          = "url"
+	QuestionTypeSingleChoice = "single_choice"
+	QuestionTypeMultiChoice  = "multi_choice" // Answers are a JSON array of the chosen options
+)
 
+// Condition operators compare another question's answer with the condition's values
 const (
-	Technical   Department = "technical"
-	Management  Department = "management"
-	SocialMedia Department = "social_media"
-	Design      Department = "design"
+	ConditionEquals   = "equals"   // The answer is exactly the value (case-insensitive for text)
+	ConditionContains = "contains" // Text answers contain the value; choice answers include it
+	ConditionOneOf    = "one_of"   // The answer, or any chosen option, is one of the values
 )
 
+// ConditionOperators lists every condition operator
+var ConditionOperators = []string{ConditionEquals, ConditionContains, ConditionOneOf}
+
+// maxShortTextLength is the longest answer a short_text question accepts, in characters
+const maxShortTextLength = 300
+
+// QuestionTypes lists every question type
+var QuestionTypes = []string{
+	QuestionTypeShortText, QuestionTypeLongText, QuestionTypeURL, QuestionTypeSingleChoice, QuestionTypeMultiChoice,
+}
+
 type Question struct {
 	ID         uuid.UUID  `json:"id" db:"id"`
-	Department Department `json:"department" db:"department"`
+	Department string     `json:"department" db:"department"`
+	Domain     *string    `json:"domain" db:"domain"` // Sub-domain the question targets; nil for the whole department
+	Type       string     `json:"type" db:"type"`
 	Body       string     `json:"body" db:"body"`
+	Options    []string   `json:"options" db:"options"` // Choices for single_choice and multi_choice questions
+	Required   bool       `json:"required" db:"required"`
+	RoundID    uuid.UUID  `json:"round_id" db:"round_id"`
+	Position   int        `json:"position" db:"position"`       // Display order within the department
+	Version    int        `json:"version" db:"version"`         // Incremented whenever the wording changes
+	ArchivedAt *time.Time `json:"archived_at" db:"archived_at"` // Archived questions are hidden from applicants
 	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at" db:"updated_at"`
+
+	Conditions []QuestionCondition `json:"conditions,omitempty" db:"-"` // The question is shown only when every condition holds
+}
+
+// QuestionCondition shows a question only when another question of the department was answered in a certain way
+type QuestionCondition struct {
+	DependsOn uuid.UUID `json:"depends_on" binding:"required"`
+	Operator  string    `json:"operator" binding:"required"`
+	Values    []string  `json:"values" binding:"required"`
+}
+
+// QuestionVersion is a wording a question has had
+type QuestionVersion struct {
+	QuestionID uuid.UUID `json:"question_id"`
+	Version    int       `json:"version"`
+	Body       string    `json:"body"`
+	CreatedAt  time.Time `json:"created_at"`
 }
 
 // CreateQuestionRequest represents the request body for creating a new question
 type CreateQuestionRequest struct {
-	Department string `json:"department" binding:"required"`
-	Body       string `json:"body" binding:"required"`
+	Department string     `json:"department" binding:"required"`
+	Domain     string     `json:"domain"` // Optional sub-domain slug within the department
+	Type       string     `json:"type"`   // Defaults to long_text
+	Body       string     `json:"body" binding:"required"`
+	Options    []string   `json:"options"`
+	Required   bool       `json:"required"`
+	RoundID    *uuid.UUID `json:"round_id"` // Defaults to the department's first round
+
+	Conditions []QuestionCondition `json:"conditions"`
+}
+
+// UpdateQuestionRequest represents the request body for editing a question; the department cannot change
+type UpdateQuestionRequest struct {
+	Domain   string     `json:"domain"` // Sub-domain slug; empty targets the whole department
+	Type     string     `json:"type"`   // Keeps the current type when omitted
+	Body     string     `json:"body" binding:"required"`
+	Options  []string   `json:"options"` // Keeps the current options when omitted and the type is unchanged
+	Required bool       `json:"required"`
+	RoundID  *uuid.UUID `json:"round_id"` // Moves the question to another round of the department when set
+
+	Conditions []QuestionCondition `json:"conditions"` // Replaces the existing conditions
+}
+
+// ReorderQuestionsRequest lists every live question of a department in the desired order
+type ReorderQuestionsRequest struct {
+	Department  string      `json:"department" binding:"required"`
+	QuestionIDs []uuid.UUID `json:"question_ids" binding:"required"`
+}
+
+// ValidateQuestionShape checks that a question type exists and that options are given exactly when the type needs them
+func ValidateQuestionShape(questionType string, options []string) error {
+	if !slices.Contains(QuestionTypes, questionType) {
+		return fmt.Errorf("type must be one of %v", QuestionTypes)
+	}
+
+	choice := questionType == QuestionTypeSingleChoice || questionType == QuestionTypeMultiChoice
+	if !choice {
+		if len(options) > 0 {
+			return fmt.Errorf("options are only allowed on %s and %s questions", QuestionTypeSingleChoice, QuestionTypeMultiChoice)
+		}
+		return nil
+	}
+
+	if len(options) < 2 {
+		return fmt.Errorf("%s questions need at least two options", questionType)
+	}
+	for i, option := range options {
+		if option == "" {
+			return fmt.Errorf("option %d is empty", i+1)
+		}
+		if slices.Contains(options[:i], option) {
+			return fmt.Errorf("option %q is listed more than once", option)
+		}
+	}
+	return nil
+}
+
+// ValidateLink checks that a value is an absolute http or https link
+func ValidateLink(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an http or https link")
+	}
+	return nil
+}
+
+// ValidateAnswerBody checks that an answer fits its question's type
+func ValidateAnswerBody(questionType string, options []string, body string) error {
+	switch questionType {
+	case QuestionTypeShortText:
+		if utf8.RuneCountInString(body) > maxShortTextLength {
+			return fmt.Errorf("answer must be at most %d characters", maxShortTextLength)
+		}
+	case QuestionTypeURL:
+		if err := ValidateLink(body); err != nil {
+			return fmt.Errorf("answer %w", err)
+		}
+	case QuestionTypeSingleChoice:
+		if !slices.Contains(options, body) {
+			return fmt.Errorf("answer must be one of %v", options)
+		}
+	case QuestionTypeMultiChoice:
+		var chosen []string
+		if err := json.Unmarshal([]byte(body), &chosen); err != nil {
+			return fmt.Errorf("answer must be a JSON array of options")
+		}
+		for i, choice := range chosen {
+			if !slices.Contains(options, choice) {
+				return fmt.Errorf("%q is not one of %v", choice, options)
+			}
+			if slices.Contains(chosen[:i], choice) {
+				return fmt.Errorf("%q is chosen more than once", choice)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateCondition checks a condition against the question it depends on
+func ValidateCondition(condition QuestionCondition, dependency Question) error {
+	if !slices.Contains(ConditionOperators, condition.Operator) {
+		return fmt.Errorf("operator must be one of %v", ConditionOperators)
+	}
+	if len(condition.Values) == 0 {
+		return fmt.Errorf("at least one value is required")
+	}
+	if condition.Operator != ConditionOneOf && len(condition.Values) != 1 {
+		return fmt.Errorf("%s takes exactly one value", condition.Operator)
+	}
+
+	if dependency.Type == QuestionTypeSingleChoice || dependency.Type == QuestionTypeMultiChoice {
+		for _, value := range condition.Values {
+			if !slices.Contains(dependency.Options, value) {
+				return fmt.Errorf("%q is not an option of question %s", value, dependency.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// Matches reports whether an answer to the dependency satisfies the condition. Blank answers never match.
+func (c QuestionCondition) Matches(dependencyType, answer string) bool {
+	answer = strings.TrimSpace(answer)
+	if answer == "" || len(c.Values) == 0 {
+		return false
+	}
+
+	if dependencyType == QuestionTypeSingleChoice || dependencyType == QuestionTypeMultiChoice {
+		chosen := []string{answer}
+		if dependencyType == QuestionTypeMultiChoice {
+			if err := json.Unmarshal([]byte(answer), &chosen); err != nil {
+				return false
+			}
+		}
+		switch c.Operator {
+		case ConditionEquals:
+			return len(chosen) == 1 && chosen[0] == c.Values[0]
+		case ConditionContains:
+			return slices.Contains(chosen, c.Values[0])
+		case ConditionOneOf:
+			return slices.ContainsFunc(chosen, func(choice string) bool { return slices.Contains(c.Values, choice) })
+		}
+		return false
+	}
+
+	switch c.Operator {
+	case ConditionEquals:
+		return strings.EqualFold(answer, c.Values[0])
+	case ConditionContains:
+		return strings.Contains(strings.ToLower(answer), strings.ToLower(c.Values[0]))
+	case ConditionOneOf:
+		return slices.ContainsFunc(c.Values, func(value string) bool { return strings.EqualFold(answer, value) })
+	}
+	return false
+}
+
+// VisibleQuestions works out which questions an applicant sees given their answers, keyed by question ID.
+// A question is hidden when any of its conditions fails, when it depends on a question missing from the list,
+// or when the question it depends on is itself hidden.
+func VisibleQuestions(questions []Question, answers map[uuid.UUID]string) map[uuid.UUID]bool {
+	byID := make(map[uuid.UUID]Question, len(questions))
+	for _, q := range questions {
+		byID[q.ID] = q
+	}
+
+	visible := make(map[uuid.UUID]bool, len(questions))
+	resolving := map[uuid.UUID]bool{}
+	var resolve func(id uuid.UUID) bool
+	resolve = func(id uuid.UUID) bool {
+		if shown, done := visible[id]; done {
+			return shown
+		}
+		q, ok := byID[id]
+		if !ok || resolving[id] {
+			return false
+		}
+
+		resolving[id] = true
+		shown := true
+		for _, condition := range q.Conditions {
+			if !resolve(condition.DependsOn) || !condition.Matches(byID[condition.DependsOn].Type, answers[condition.DependsOn]) {
+				shown = false
+				break
+			}
+		}
+		delete(resolving, id)
+
+		visible[id] = shown
+		return shown
+	}
+
+	for _, q := range questions {
+		resolve(q.ID)
+	}
+	return visible
 }
@@ -0,0 +1,121 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestQuestionConditionMatches(t *testing.T) {
+	tests := []struct {
+		name           string
+		condition      QuestionCondition
+		dependencyType string
+		answer         string
+		want           bool
+	}{
+		{"text equals ignores case and spacing", QuestionCondition{Operator: ConditionEquals, Values: []string{"Yes"}}, QuestionTypeShortText, "  yes ", true},
+		{"text equals needs the whole answer", QuestionCondition{Operator: ConditionEquals, Values: []string{"yes"}}, QuestionTypeShortText, "yes please", false},
+		{"text contains", QuestionCondition{Operator: ConditionContains, Values: []string{"go"}}, QuestionTypeLongText, "I mostly write Go", true},
+		{"text one_of", QuestionCondition{Operator: ConditionOneOf, Values: []string{"go", "rust"}}, QuestionTypeShortText, "Rust", true},
+		{"text one_of misses", QuestionCondition{Operator: ConditionOneOf, Values: []string{"go", "rust"}}, QuestionTypeShortText, "zig", false},
+		{"blank answers never match", QuestionCondition{Operator: ConditionContains, Values: []string{""}}, QuestionTypeLongText, "   ", false},
+		{"conditions without values never match", QuestionCondition{Operator: ConditionOneOf}, QuestionTypeShortText, "go", false},
+		{"single choice equals is exact", QuestionCondition{Operator: ConditionEquals, Values: []string{"Backend"}}, QuestionTypeSingleChoice, "backend", false},
+		{"single choice one_of", QuestionCondition{Operator: ConditionOneOf, Values: []string{"Backend", "DevOps"}}, QuestionTypeSingleChoice, "DevOps", true},
+		{"multi choice contains", QuestionCondition{Operator: ConditionContains, Values: []string{"Go"}}, QuestionTypeMultiChoice, `["Rust","Go"]`, true},
+		{"multi choice equals needs a single choice", QuestionCondition{Operator: ConditionEquals, Values: []string{"Go"}}, QuestionTypeMultiChoice, `["Rust","Go"]`, false},
+		{"multi choice one_of", QuestionCondition{Operator: ConditionOneOf, Values: []string{"C", "Go"}}, QuestionTypeMultiChoice, `["Go"]`, true},
+		{"malformed multi choice answers never match", QuestionCondition{Operator: ConditionContains, Values: []string{"Go"}}, QuestionTypeMultiChoice, "Go", false},
+		{"unknown operators never match", QuestionCondition{Operator: "starts_with", Values: []string{"G"}}, QuestionTypeShortText, "Go", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.condition.Matches(tt.dependencyType, tt.answer); got != tt.want {
+				t.Errorf("Matches(%q, %q) = %v, want %v", tt.dependencyType, tt.answer, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVisibleQuestions(t *testing.T) {
+	// experience asks for a level; projects is shown to experienced applicants and stack to those whose projects
+	// mention Go, so stack depends on experience through projects
+	experience := Question{ID: uuid.New(), Type: QuestionTypeSingleChoice, Options: []string{"None", "Some", "Lots"}}
+	projects := Question{ID: uuid.New(), Type: QuestionTypeLongText, Conditions: []QuestionCondition{
+		{DependsOn: experience.ID, Operator: ConditionOneOf, Values: []string{"Some", "Lots"}},
+	}}
+	stack := Question{ID: uuid.New(), Type: QuestionTypeShortText, Conditions: []QuestionCondition{
+		{DependsOn: projects.ID, Operator: ConditionContains, Values: []string{"go"}},
+	}}
+	// both needs two conditions to hold
+	both := Question{ID: uuid.New(), Type: QuestionTypeShortText, Conditions: []QuestionCondition{
+		{DependsOn: experience.ID, Operator: ConditionEquals, Values: []string{"Lots"}},
+		{DependsOn: projects.ID, Operator: ConditionContains, Values: []string{"team"}},
+	}}
+	// orphan depends on a question that is not in the list, such as an archived one
+	orphan := Question{ID: uuid.New(), Type: QuestionTypeShortText, Conditions: []QuestionCondition{
+		{DependsOn: uuid.New(), Operator: ConditionEquals, Values: []string{"x"}},
+	}}
+	// loopA and loopB depend on each other
+	loopA := Question{ID: uuid.New(), Type: QuestionTypeShortText}
+	loopB := Question{ID: uuid.New(), Type: QuestionTypeShortText, Conditions: []QuestionCondition{
+		{DependsOn: loopA.ID, Operator: ConditionEquals, Values: []string{"b"}},
+	}}
+	loopA.Conditions = []QuestionCondition{{DependsOn: loopB.ID, Operator: ConditionEquals, Values: []string{"a"}}}
+
+	// Dependents come before their dependencies to check the order of the list does not matter
+	questions := []Question{both, stack, projects, experience, orphan, loopA, loopB}
+
+	tests := []struct {
+		name    string
+		answers map[uuid.UUID]string
+		visible []uuid.UUID
+	}{
+		{
+			name:    "no answers",
+			answers: map[uuid.UUID]string{},
+			visible: []uuid.UUID{experience.ID},
+		},
+		{
+			name:    "one level deep",
+			answers: map[uuid.UUID]string{experience.ID: "Some", projects.ID: "A team CLI in Rust"},
+			visible: []uuid.UUID{experience.ID, projects.ID},
+		},
+		{
+			name:    "chained and combined conditions",
+			answers: map[uuid.UUID]string{experience.ID: "Lots", projects.ID: "A Go service built by a team"},
+			visible: []uuid.UUID{experience.ID, projects.ID, stack.ID, both.ID},
+		},
+		{
+			// The stale projects answer still mentions Go, but projects is hidden, so stack is too
+			name:    "hidden dependencies hide their dependents",
+			answers: map[uuid.UUID]string{experience.ID: "None", projects.ID: "A Go service built by a team"},
+			visible: []uuid.UUID{experience.ID},
+		},
+		{
+			name:    "loops stay hidden",
+			answers: map[uuid.UUID]string{loopA.ID: "a", loopB.ID: "b"},
+			visible: []uuid.UUID{experience.ID},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visible := VisibleQuestions(questions, tt.answers)
+			if len(visible) != len(questions) {
+				t.Errorf("got %d questions, want %d", len(visible), len(questions))
+			}
+			want := map[uuid.UUID]bool{}
+			for _, id := range tt.visible {
+				want[id] = true
+			}
+			for _, q := range questions {
+				if visible[q.ID] != want[q.ID] {
+					t.Errorf("question %s visible = %v, want %v", q.ID, visible[q.ID], want[q.ID])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// QuestionBankVersion is the bank format version this server reads and writes
+const QuestionBankVersion = 1
+
+// Question bank change actions
+const (
+	QuestionBankCreate    = "create"
+	QuestionBankUpdate    = "update"
+	QuestionBankUnchanged = "unchanged"
+	QuestionBankRemove    = "remove" // Only with prune; answered questions are archived rather than deleted
+)
+
+// QuestionBank is a portable set of questions, read from and written to YAML or JSON
+type QuestionBank struct {
+	Version   int                 `json:"version" yaml:"version"`
+	Questions []QuestionBankEntry `json:"questions" yaml:"questions"`
+}
+
+// QuestionBankEntry is a single question in a bank. Entries with an ID update that question;
+// entries without one are matched to an existing question of the department by their exact wording.
+type QuestionBankEntry struct {
+	ID         *uuid.UUID `json:"id,omitempty" yaml:"id,omitempty"`
+	Department string     `json:"department" yaml:"department"`
+	Domain     string     `json:"domain,omitempty" yaml:"domain,omitempty"`
+	Type       string     `json:"type" yaml:"type"`
+	Body       string     `json:"body" yaml:"body"`
+	Options    []string   `json:"options,omitempty" yaml:"options,omitempty"`
+	Required   bool       `json:"required" yaml:"required"`
+	Order      int        `json:"order,omitempty" yaml:"order,omitempty"` // Position within the department; defaults to the entry's place in the file
+	Round      string     `json:"round,omitempty" yaml:"round,omitempty"` // Round name or ID; new questions default to the first round, existing ones keep theirs
+
+	Conditions []QuestionBankCondition `json:"conditions,omitempty" yaml:"conditions,omitempty"` // Replace the question's visibility conditions
+}
+
+// QuestionBankCondition is a visibility condition in a bank. DependsOn names another question of the same department
+// by its ID or by its exact wording, so a bank can add a question together with the questions it depends on.
+type QuestionBankCondition struct {
+	DependsOn string   `json:"depends_on" yaml:"depends_on"`
+	Operator  string   `json:"operator" yaml:"operator"`
+	Values    []string `json:"values" yaml:"values"`
+}
+
+// QuestionBankIssue is a validation problem at a position in the bank file
+type QuestionBankIssue struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+func (i QuestionBankIssue) String() string {
+	position := fmt.Sprintf("%d:%d", i.Line, i.Column)
+	if i.Column == 0 {
+		position = fmt.Sprintf("%d", i.Line)
+	}
+	if i.Field != "" {
+		return fmt.Sprintf("%s: %s: %s", position, i.Field, i.Message)
+	}
+	return fmt.Sprintf("%s: %s", position, i.Message)
+}
+
+// QuestionBankChange describes what importing a bank does to one question
+type QuestionBankChange struct {
+	Action     string     `json:"action"`
+	QuestionID *uuid.UUID `json:"question_id,omitempty"`
+	Department string     `json:"department"`
+	Body       string     `json:"body"`
+	Line       int        `json:"line,omitempty"`   // Entry position in the bank; zero for removals
+	Fields     []string   `json:"fields,omitempty"` // Fields that change on update
+}
+
+// QuestionBankImportResult is the outcome of an import, or of a dry run
+type QuestionBankImportResult struct {
+	DryRun  bool                 `json:"dry_run"`
+	Changes []QuestionBankChange `json:"changes"`
+	Summary map[string]int       `json:"summary"`
+}
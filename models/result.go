@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Offer statuses of a selected applicant's result
+const (
+	OfferPending  = "pending"
+	OfferAccepted = "accepted"
+	OfferDeclined = "declined"
+	OfferExpired  = "expired"
+)
+
+// ResultOutcomes are the application statuses that become a result when a department stages its results
+var ResultOutcomes = []string{
+	ApplicationStatusSelected, ApplicationStatusWaitlisted, ApplicationStatusRejected, ApplicationStatusReleased,
+}
+
+// DepartmentResults is a department's staged results publication
+type DepartmentResults struct {
+	Department       string     `json:"department"`
+	PublishAt        time.Time  `json:"publish_at"`
+	ResponseDeadline *time.Time `json:"response_deadline"` // When offers lapse; nil means offers stay open
+	Published        bool       `json:"published"`
+	AnnouncedAt      *time.Time `json:"announced_at"`
+	StagedBy         *uuid.UUID `json:"staged_by"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// StageResultsRequest represents the request body for staging a department's results
+type StageResultsRequest struct {
+	PublishAt        time.Time            `json:"publish_at" binding:"required"`
+	ResponseDeadline *time.Time           `json:"response_deadline"`
+	Feedback         map[uuid.UUID]string `json:"feedback"` // Optional feedback per application ID
+}
+
+// SetResultFeedbackRequest represents the request body for setting the feedback on a result
+type SetResultFeedbackRequest struct {
+	Feedback string `json:"feedback" binding:"max=5000"`
+}
+
+// ApplicationResult is the outcome of one application
+type ApplicationResult struct {
+	ApplicationID    uuid.UUID  `json:"application_id"`
+	Department       string     `json:"department"`
+	Outcome          string     `json:"outcome"`
+	Feedback         string     `json:"feedback"`
+	OfferStatus      *string    `json:"offer_status"`
+	ResponseDeadline *time.Time `json:"response_deadline"`
+	RespondedAt      *time.Time `json:"responded_at"`
+	WaitlistPosition *int       `json:"waitlist_position"` // Place on the department's waitlist; nil unless waitlisted
+	PromotedAt       *time.Time `json:"promoted_at"`       // When the result was promoted from the waitlist
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// Expired reports whether the result's offer is still pending past its response deadline
+func (r *ApplicationResult) Expired(now time.Time) bool {
+	return r.OfferStatus != nil && *r.OfferStatus == OfferPending && r.ResponseDeadline != nil && now.After(*r.ResponseDeadline)
+}
+
+// MyResult is an applicant's view of one application's result; the outcome is only filled in once published
+type MyResult struct {
+	ApplicationID uuid.UUID          `json:"application_id"`
+	Department    string             `json:"department"`
+	Published     bool               `json:"published"`
+	Result        *ApplicationResult `json:"result,omitempty"`
+}
+
+// OfferResponse is the payload of the offer.accepted and offer.declined events
+type OfferResponse struct {
+	Application Application       `json:"application"`
+	Result      ApplicationResult `json:"result"`
+}
+
+// ResultsPublication is the payload of a results.published event, raised once per published application
+type ResultsPublication struct {
+	Application Application       `json:"application"`
+	Result      ApplicationResult `json:"result"`
+}
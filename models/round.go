@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultRoundName names the application form round every department starts with
+const DefaultRoundName = "Application"
+
+// Round is a stage of a department's recruitment, such as the application form, a task or an interview.
+// Every applicant is admitted to the first round; admins advance applicants to later rounds.
+type Round struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	Department  string    `json:"department" db:"department"`
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	Position    int       `json:"position" db:"position"` // Order within the department, starting at 1
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateRoundRequest represents the request body for adding a round at the end of a department's pipeline
+type CreateRoundRequest struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description"`
+}
+
+// UpdateRoundRequest represents the request body for renaming a round; its position cannot change
+type UpdateRoundRequest struct {
+	Name        string `json:"name" binding:"required,max=100"`
+	Description string `json:"description"`
+}
+
+// AdvanceApplicationsRequest lists the applications to admit to a round
+type AdvanceApplicationsRequest struct {
+	ApplicationIDs []uuid.UUID `json:"application_ids" binding:"required,min=1"`
+}
+
+// AdvanceApplicationsResult reports which applications were admitted to a round and why others were skipped
+type AdvanceApplicationsResult struct {
+	Round    Round                `json:"round"`
+	Advanced []uuid.UUID          `json:"advanced"`
+	Skipped  []SkippedApplication `json:"skipped"`
+}
+
+// SkippedApplication is an application that could not be advanced, with the reason
+type SkippedApplication struct {
+	ApplicationID uuid.UUID `json:"application_id"`
+	Reason        string    `json:"reason"`
+}
+
+// ApplicationRound is a round an application has been admitted to, as shown to the applicant
+type ApplicationRound struct {
+	RoundID     uuid.UUID `json:"round_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Position    int       `json:"position"`
+	AdmittedAt  time.Time `json:"admitted_at"`
+}
+
+// ApplicationAdvance is the payload of an application.advanced event
+type ApplicationAdvance struct {
+	Application Application `json:"application"`
+	Round       Round       `json:"round"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"html"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Markers around the matches in a raw search snippet, as emitted by SearchAnswersQuery
+const (
+	snippetStartSel = "\uE000"
+	snippetStopSel  = "\uE001"
+)
+
+// HighlightSnippet turns a raw search snippet into HTML: the answer text is escaped and only the
+// matches are wrapped in <mark>, so applicant-written markup is never rendered.
+func HighlightSnippet(raw string) string {
+	return strings.NewReplacer(
+		snippetStartSel, "<mark>",
+		snippetStopSel, "</mark>",
+	).Replace(html.EscapeString(raw))
+}
+
+// AnswerSearchResult is a ranked full-text match within an applicant's answer
+type AnswerSearchResult struct {
+	AnswerID      uuid.UUID  `json:"answer_id"`
+	ApplicationID uuid.UUID  `json:"application_id"`
+	ApplicantCode string     `json:"applicant_code"`
+	Blind         bool       `json:"blind"` // The applicant's identity is hidden by blind review
+	UserID        *uuid.UUID `json:"user_id,omitempty"`
+	FullName      string     `json:"full_name,omitempty"`
+	RegNum        string     `json:"reg_num,omitempty"`
+	QuestionID    uuid.UUID  `json:"question_id"`
+	Question      string     `json:"question"`
+	Department    string     `json:"department"`
+	Snippet       string     `json:"snippet"` // HTML-escaped, with matches in <mark>
+	Rank          float32    `json:"rank"`
+}
+
+// Anonymise replaces the applicant's identity with their pseudonymous code when the application is blind-reviewed
+func (r *AnswerSearchResult) Anonymise() {
+	r.ApplicantCode = ApplicantCode(r.ApplicationID)
+	if r.Blind {
+		r.UserID, r.FullName, r.RegNum = nil, "", ""
+	}
+}
+
+// SetEvaluatorDepartmentsRequest represents the request body for restricting an evaluator to departments
+type SetEvaluatorDepartmentsRequest struct {
+	Departments []string `json:"departments" binding:"required"`
+}
@@ -0,0 +1,89 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Shortlist scoring modes
+const (
+	ShortlistScoreRaw        = "raw"        // Mean of the raw evaluation scores
+	ShortlistScoreNormalised = "normalised" // Mean of the evaluators' z-scores, see CalibrateScores
+)
+
+// Shortlist tie-breakers, applied in the order given after the score
+const (
+	TieBreakRawScore    = "raw_score"    // Higher raw mean first
+	TieBreakEvaluations = "evaluations"  // More evaluations first
+	TieBreakPreference  = "preference"   // Applicants who ranked the department higher first; unranked last
+	TieBreakSubmittedAt = "submitted_at" // Earlier submission first
+)
+
+// ShortlistTieBreakers lists every shortlist tie-breaker
+var ShortlistTieBreakers = []string{TieBreakRawScore, TieBreakEvaluations, TieBreakPreference, TieBreakSubmittedAt}
+
+// DefaultShortlistTieBreakers are used when a request does not name any
+var DefaultShortlistTieBreakers = []string{TieBreakEvaluations, TieBreakPreference, TieBreakSubmittedAt}
+
+// ShortlistStatuses are the statuses a shortlist can move applications to
+var ShortlistStatuses = []string{
+	ApplicationStatusSubmitted, ApplicationStatusSelected, ApplicationStatusRejected, ApplicationStatusWaitlisted,
+}
+
+// ShortlistRequest configures a department shortlist run
+type ShortlistRequest struct {
+	Score           string   `json:"score" binding:"omitempty,oneof=raw normalised"` // Defaults to raw
+	Capacity        *int     `json:"capacity" binding:"omitempty,min=0"`             // Top-N; defaults to the department's capacity
+	MinScore        *float64 `json:"min_score"`                                      // Cutoff on the chosen score
+	TieBreakers     []string `json:"tie_breakers"`
+	Status          string   `json:"status"`           // Status for shortlisted applications; defaults to selected
+	RemainderStatus string   `json:"remainder_status"` // Status for the rest; empty leaves them unchanged
+	Notify          bool     `json:"notify"`           // Email applicants whose status changed
+	DryRun          bool     `json:"dry_run"`
+}
+
+// ShortlistCandidate is an application taking part in a shortlist run
+type ShortlistCandidate struct {
+	ApplicationID   uuid.UUID
+	UserID          uuid.UUID
+	Email           string
+	Status          string
+	Preference      *int
+	SubmittedAt     *time.Time
+	Evaluations     int
+	RawScore        float64
+	NormalisedScore float64
+}
+
+// ShortlistEntry is a ranked application and whether it made the shortlist
+type ShortlistEntry struct {
+	Rank            int       `json:"rank"`
+	ApplicationID   uuid.UUID `json:"application_id"`
+	ApplicantCode   string    `json:"applicant_code"`
+	Status          string    `json:"status"`
+	Score           float64   `json:"score"`
+	RawScore        float64   `json:"raw_score"`
+	NormalisedScore float64   `json:"normalised_score"`
+	Evaluations     int       `json:"evaluations"`
+	Preference      *int      `json:"preference"`
+	Tied            bool      `json:"tied"` // Shares its score with another entry and was placed by the tie-breakers
+	Shortlisted     bool      `json:"shortlisted"`
+	NewStatus       string    `json:"new_status,omitempty"`
+}
+
+// ShortlistResult is the outcome of a shortlist run
+type ShortlistResult struct {
+	Department  string           `json:"department"`
+	Score       string           `json:"score"`
+	Capacity    *int             `json:"capacity"`
+	MinScore    *float64         `json:"min_score"`
+	TieBreakers []string         `json:"tie_breakers"`
+	Entries     []ShortlistEntry `json:"entries"`
+	Shortlisted int              `json:"shortlisted"`
+	Unscored    []uuid.UUID      `json:"unscored"` // Applications without evaluations, left out of the ranking
+	DryRun      bool             `json:"dry_run"`
+	Changed     int              `json:"changed"`
+	Notified    int              `json:"notified"`
+	Withheld    int              `json:"withheld"` // Decisions emailed once the department's results are published
+}
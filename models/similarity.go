@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SimilarityFlag is a pair of answers to the same question that are suspiciously alike
+type SimilarityFlag struct {
+	ID         uuid.UUID            `json:"id"`
+	QuestionID uuid.UUID            `json:"question_id"`
+	Question   string               `json:"question"`
+	Department string               `json:"department"`
+	Similarity float32              `json:"similarity"`
+	A          SimilarityFlagAnswer `json:"a"`
+	B          SimilarityFlagAnswer `json:"b"`
+	CheckedAt  time.Time            `json:"checked_at"`
+	CreatedAt  time.Time            `json:"created_at"`
+}
+
+// SimilarityFlagAnswer is one side of a similarity flag
+type SimilarityFlagAnswer struct {
+	AnswerID      uuid.UUID  `json:"answer_id"`
+	ApplicationID uuid.UUID  `json:"application_id"`
+	ApplicantCode string     `json:"applicant_code"`
+	Blind         bool       `json:"blind"` // The applicant's identity is hidden by blind review
+	UserID        *uuid.UUID `json:"user_id,omitempty"`
+	FullName      string     `json:"full_name,omitempty"`
+	RegNum        string     `json:"reg_num,omitempty"`
+	Body          string     `json:"body"`
+}
+
+// Anonymise replaces the applicant's identity with their pseudonymous code when the application is blind-reviewed
+func (a *SimilarityFlagAnswer) Anonymise() {
+	a.ApplicantCode = ApplicantCode(a.ApplicationID)
+	if a.Blind {
+		a.UserID, a.FullName, a.RegNum = nil, "", ""
+	}
+}
@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// RecruitmentStats is the aggregate funnel view returned to admins
+type RecruitmentStats struct {
+	Users       UserStats         `json:"users"`
+	Departments []DepartmentStats `json:"departments"`
+	Evaluations EvaluationStats   `json:"evaluations"`
+	Daily       []DailyStats      `json:"daily"`
+	GeneratedAt time.Time         `json:"generated_at"`
+}
+
+// UserStats summarises applicant registrations
+type UserStats struct {
+	Registrations int `json:"registrations"`
+	Verified      int `json:"verified"`
+	ChickenedOut  int `json:"chickened_out"`
+	WithoutApps   int `json:"without_applications"`
+}
+
+// DepartmentStats summarises the application funnel of a single department
+type DepartmentStats struct {
+	Department                string  `json:"department"`
+	ApplicationsCreated       int     `json:"applications_created"`
+	ApplicationsSubmitted     int     `json:"applications_submitted"`
+	ApplicationsEvaluated     int     `json:"applications_evaluated"`
+	ChickenedOut              int     `json:"chickened_out"`
+	AverageAnswersPerApp      float64 `json:"average_answers_per_application"`
+	EvaluationProgressPercent float64 `json:"evaluation_progress_percent"`
+}
+
+// EvaluationStats summarises evaluation activity across all departments
+type EvaluationStats struct {
+	Total            int     `json:"total"`
+	Evaluators       int     `json:"evaluators"`
+	AverageScore     float64 `json:"average_score"`
+	SubmittedPending int     `json:"submitted_pending"`
+}
+
+// DailyStats holds per-day activity counts for time series charts
+type DailyStats struct {
+	Date                  string `json:"date"`
+	Registrations         int    `json:"registrations"`
+	ApplicationsCreated   int    `json:"applications_created"`
+	ApplicationsSubmitted int    `json:"applications_submitted"`
+	Evaluations           int    `json:"evaluations"`
+}
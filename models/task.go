@@ -0,0 +1,137 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Task is a take-home assignment attached to a round. Applicants admitted to the round submit links to their work
+// before their deadline; submissions within the late window are accepted but flagged as late.
+type Task struct {
+	ID                uuid.UUID        `json:"id" db:"id"`
+	RoundID           uuid.UUID        `json:"round_id" db:"round_id"`
+	Department        string           `json:"department" db:"department"`
+	Title             string           `json:"title" db:"title"`
+	Description       string           `json:"description" db:"description"` // Markdown
+	Attachments       []TaskAttachment `json:"attachments" db:"attachments"`
+	Deadline          time.Time        `json:"deadline" db:"deadline"`                       // Applies unless the applicant was granted an extension
+	LateWindowMinutes int              `json:"late_window_minutes" db:"late_window_minutes"` // How long after the deadline late submissions are accepted
+	CreatedBy         *uuid.UUID       `json:"created_by" db:"created_by"`
+	CreatedAt         time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// TaskAttachment is a named link handed out with a task, such as a starter repository or a design file
+type TaskAttachment struct {
+	Name string `json:"name" binding:"required,max=200"`
+	URL  string `json:"url" binding:"required"`
+}
+
+// TaskRequest represents the request body for creating or replacing a task
+type TaskRequest struct {
+	Title             string           `json:"title" binding:"required,max=200"`
+	Description       string           `json:"description"`
+	Attachments       []TaskAttachment `json:"attachments" binding:"dive"`
+	Deadline          time.Time        `json:"deadline" binding:"required"`
+	LateWindowMinutes int              `json:"late_window_minutes" binding:"min=0"`
+}
+
+// Validate checks the attachment links
+func (r TaskRequest) Validate() error {
+	for i, attachment := range r.Attachments {
+		if err := ValidateLink(attachment.URL); err != nil {
+			return fmt.Errorf("attachment %d url %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// TaskExtension moves a task's deadline for one application
+type TaskExtension struct {
+	TaskID        uuid.UUID  `json:"task_id"`
+	ApplicationID uuid.UUID  `json:"application_id"`
+	Deadline      time.Time  `json:"deadline"`
+	Reason        string     `json:"reason"`
+	GrantedBy     *uuid.UUID `json:"granted_by"`
+	GrantedAt     time.Time  `json:"granted_at"`
+}
+
+// GrantTaskExtensionRequest represents the request body for granting an applicant a new deadline
+type GrantTaskExtensionRequest struct {
+	Deadline time.Time `json:"deadline" binding:"required"`
+	Reason   string    `json:"reason" binding:"max=1000"`
+}
+
+// TaskSubmission is an applicant's work for a task
+type TaskSubmission struct {
+	ID            uuid.UUID  `json:"id"`
+	TaskID        uuid.UUID  `json:"task_id"`
+	ApplicationID uuid.UUID  `json:"application_id"`
+	ApplicantCode string     `json:"applicant_code,omitempty"` // Set for reviewers
+	Blind         bool       `json:"blind,omitempty"`          // The applicant's identity is hidden by blind review
+	UserID        *uuid.UUID `json:"user_id,omitempty"`
+	RepositoryURL string     `json:"repository_url"`
+	DeployURL     *string    `json:"deploy_url"`
+	Notes         string     `json:"notes"`
+	Late          bool       `json:"late"` // Submitted after the deadline, within the late window
+	SubmittedAt   time.Time  `json:"submitted_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+
+	Grades []TaskGrade `json:"grades,omitempty"`
+}
+
+// Anonymise replaces the applicant's identity with their pseudonymous code when the application is blind-reviewed
+func (s *TaskSubmission) Anonymise() {
+	s.ApplicantCode = ApplicantCode(s.ApplicationID)
+	if s.Blind {
+		s.UserID = nil
+	}
+}
+
+// SubmitTaskRequest represents the request body for submitting or resubmitting a task
+type SubmitTaskRequest struct {
+	RepositoryURL string `json:"repository_url" binding:"required"`
+	DeployURL     string `json:"deploy_url"`
+	Notes         string `json:"notes" binding:"max=5000"`
+}
+
+// Validate checks the submitted links
+func (r SubmitTaskRequest) Validate() error {
+	if err := ValidateLink(r.RepositoryURL); err != nil {
+		return fmt.Errorf("repository_url %w", err)
+	}
+	if r.DeployURL != "" {
+		if err := ValidateLink(r.DeployURL); err != nil {
+			return fmt.Errorf("deploy_url %w", err)
+		}
+	}
+	return nil
+}
+
+// ApplicantTask is a task as shown to an applicant, with their own deadline and submission
+type ApplicantTask struct {
+	Task
+	ApplicationID uuid.UUID       `json:"application_id"`
+	DueAt         time.Time       `json:"due_at"`    // The applicant's deadline, including any extension
+	ClosesAt      time.Time       `json:"closes_at"` // Last moment a late submission is accepted
+	Submission    *TaskSubmission `json:"submission"`
+}
+
+// TaskGrade is an evaluator's score for a task submission, on the same 0-10 scale as application evaluations
+type TaskGrade struct {
+	ID           uuid.UUID `json:"id"`
+	SubmissionID uuid.UUID `json:"submission_id"`
+	EvaluatorID  uuid.UUID `json:"evaluator_id"`
+	Score        int       `json:"score"`
+	Notes        string    `json:"notes"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// GradeTaskSubmissionRequest represents the request body for grading a task submission
+type GradeTaskSubmissionRequest struct {
+	Score *int   `json:"score" binding:"required,min=0,max=10"`
+	Notes string `json:"notes"`
+}
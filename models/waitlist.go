@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// WaitlistSeats is the capacity accounting of a department's published results.
+// Accepted and pending offers hold a seat; declined and expired offers give theirs back.
+type WaitlistSeats struct {
+	Capacity   int `json:"capacity"` // The department's capacity, or the number of offers made at publication without one
+	Accepted   int `json:"accepted"`
+	Pending    int `json:"pending"`
+	Available  int `json:"available"`
+	Waitlisted int `json:"waitlisted"`
+}
+
+// WaitlistEntry is a waitlisted result with its applicant
+type WaitlistEntry struct {
+	ApplicationResult
+	Status   string `json:"status"`
+	FullName string `json:"full_name"`
+	Email    string `json:"email"`
+}
+
+// Waitlist is a department's ordered waitlist
+type Waitlist struct {
+	Department string          `json:"department"`
+	Published  bool            `json:"published"`
+	Seats      WaitlistSeats   `json:"seats"`
+	Entries    []WaitlistEntry `json:"entries"`
+}
+
+// ReorderWaitlistRequest represents the request body for reordering a department's waitlist
+type ReorderWaitlistRequest struct {
+	ApplicationIDs []uuid.UUID `json:"application_ids" binding:"required"` // Every waitlisted application, first in line first
+}
+
+// WaitlistPromotion is the payload of a waitlist.promoted event, raised when a waitlisted applicant is offered a freed seat
+type WaitlistPromotion struct {
+	Application Application       `json:"application"`
+	Result      ApplicationResult `json:"result"`
+}
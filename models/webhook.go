@@ -0,0 +1,50 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook represents an outbound webhook subscription
+type Webhook struct {
+	ID         uuid.UUID   `json:"id" db:"id"`
+	URL        string      `json:"url" db:"url"`
+	Secret     string      `json:"-" db:"secret"` // Only returned once, on creation
+	EventTypes []EventType `json:"event_types" db:"event_types"`
+	Active     bool        `json:"active" db:"active"`
+	CreatedBy  *uuid.UUID  `json:"created_by" db:"created_by"`
+	CreatedAt  time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// WebhookDelivery represents a single delivery attempt of an event to a webhook
+type WebhookDelivery struct {
+	ID            uuid.UUID       `json:"id" db:"id"`
+	WebhookID     uuid.UUID       `json:"webhook_id" db:"webhook_id"`
+	EventID       uuid.UUID       `json:"event_id" db:"event_id"`
+	EventType     EventType       `json:"event_type" db:"event_type"`
+	Payload       json.RawMessage `json:"payload" db:"payload"`
+	Attempt       int             `json:"attempt" db:"attempt"`
+	Success       bool            `json:"success" db:"success"`
+	StatusCode    *int            `json:"status_code" db:"status_code"`
+	Error         *string         `json:"error" db:"error"`
+	DurationMs    int             `json:"duration_ms" db:"duration_ms"`
+	NextAttemptAt *time.Time      `json:"next_attempt_at" db:"next_attempt_at"` // When a failed attempt is retried, or its lease lapses while the retry is in flight; nil once retried or when none is left
+	CreatedAt     time.Time       `json:"created_at" db:"created_at"`
+}
+
+// CreateWebhookRequest represents the request body for creating a webhook
+type CreateWebhookRequest struct {
+	URL        string      `json:"url" binding:"required,url"`
+	Secret     string      `json:"secret,omitempty"` // Generated when omitted
+	EventTypes []EventType `json:"event_types" binding:"required,min=1"`
+}
+
+// UpdateWebhookRequest represents the request body for updating a webhook
+type UpdateWebhookRequest struct {
+	URL        string      `json:"url" binding:"required,url"`
+	EventTypes []EventType `json:"event_types" binding:"required,min=1"`
+	Active     *bool       `json:"active" binding:"required"`
+}
@@ -0,0 +1,374 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SetApplicationPreferences handles PUT /applications/me/preferences - ranks the current user's applications, first choice first
+func SetApplicationPreferences(c *gin.Context) {
+	var req models.SetApplicationPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	for i, id := range req.ApplicationIDs {
+		if slices.Contains(req.ApplicationIDs[:i], id) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Each application can only be ranked once", "application_id": id})
+			return
+		}
+	}
+
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to start transaction",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	// Clearing first keeps the (user, preference) unique index satisfied while ranks move around
+	if _, err := tx.Exec(ctx, queries.ClearUserApplicationPreferencesQuery, userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to clear preferences",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	now := time.Now()
+	applications := make([]models.Application, 0, len(req.ApplicationIDs))
+	for i, id := range req.ApplicationIDs {
+		var app models.Application
+		err := tx.QueryRow(ctx, queries.SetApplicationPreferenceQuery, id, userID, i+1, now).Scan(
+			&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference,
+			&app.CreatedAt, &app.UpdatedAt,
+		)
+		if err != nil {
+			if err.Error() == "no rows in result set" {
+				c.JSON(http.StatusNotFound, gin.H{"error": "Application not found, withdrawn or access denied", "application_id": id})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to save preferences",
+				"details": err.Error(),
+			})
+			return
+		}
+		applications = append(applications, app)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to save preferences",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Decisions stay hidden until the department's results are published
+	if err := maskUnpublishedDecisions(ctx, applications); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to check results publication",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":      "Preferences saved successfully",
+		"applications": applications,
+	})
+}
+
+// SetApplicationDecision handles PUT /admin/applications/:id/status - records a selection decision on a submitted application
+func SetApplicationDecision(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	var req models.SetApplicationDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+	if !slices.Contains(models.ApplicationDecisionStatuses, req.Status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status", "statuses": models.ApplicationDecisionStatuses})
+		return
+	}
+
+	ctx := context.Background()
+
+	var application models.Application
+	err = services.DB.QueryRow(ctx, queries.GetApplicationByIDQuery, applicationID).Scan(
+		&application.ID, &application.UserID, &application.Department, &application.Submitted,
+		&application.Status, &application.Preference, &application.CreatedAt, &application.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch application",
+			"details": err.Error(),
+		})
+		return
+	}
+	if !application.Reviewable() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Only submitted applications can receive a decision"})
+		return
+	}
+	if !requireResultsUnpublished(c, ctx, application.Department) {
+		return
+	}
+
+	previousStatus := application.Status
+	err = services.DB.QueryRow(ctx, queries.UpdateApplicationStatusQuery, applicationID, req.Status, time.Now()).Scan(
+		&application.ID, &application.UserID, &application.Department, &application.Submitted,
+		&application.Status, &application.Preference, &application.CreatedAt, &application.UpdatedAt,
+	)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update application status",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if previousStatus != application.Status {
+		services.PublishEvent(models.EventApplicationStatusChanged, models.ApplicationStatusChange{
+			Application:    application,
+			PreviousStatus: previousStatus,
+			Status:         application.Status,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "Application status updated successfully",
+		"application": application,
+	})
+}
+
+// AllocateApplications handles POST /admin/allocation - resolves applicants selected by several departments
+// using their preference order and department capacity. With dry_run the plan is returned without changes.
+func AllocateApplications(c *gin.Context) {
+	var req models.AllocationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+	for department, capacity := range req.Capacities {
+		if capacity < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department capacity", "department": department})
+			return
+		}
+		if !requireDepartment(c, department) {
+			return
+		}
+	}
+
+	ctx := context.Background()
+	capacities := req.Capacities
+	if capacities == nil {
+		var err error
+		if capacities, err = fetchDepartmentCapacities(ctx); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to fetch department capacities",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
+	rows, err := services.DB.Query(ctx, queries.GetAllocationCandidatesQuery)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch selected applications",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	var candidates []models.AllocationCandidate
+	for rows.Next() {
+		var candidate models.AllocationCandidate
+		err := rows.Scan(
+			&candidate.ApplicationID, &candidate.UserID, &candidate.Department, &candidate.Preference,
+			&candidate.Score, &candidate.SubmittedAt,
+		)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan selected application",
+				"details": err.Error(),
+			})
+			return
+		}
+		candidates = append(candidates, candidate)
+	}
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading selected applications",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	results := services.AllocateSelections(candidates, capacities)
+
+	if !req.DryRun {
+		var checked []string
+		for _, result := range results {
+			if result.Outcome == models.AllocationKept || slices.Contains(checked, result.Department) {
+				continue
+			}
+			if !requireResultsUnpublished(c, ctx, result.Department) {
+				return
+			}
+			checked = append(checked, result.Department)
+		}
+
+		changed, err := applyAllocation(ctx, results)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to apply allocation",
+				"details": err.Error(),
+			})
+			return
+		}
+		for _, change := range changed {
+			services.PublishEvent(models.EventApplicationStatusChanged, change)
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Allocation completed successfully",
+		"dry_run": req.DryRun,
+		"results": results,
+		"summary": summariseAllocation(results, capacities),
+	})
+}
+
+// applyAllocation moves released and waitlisted applications out of the selected status in one transaction
+func applyAllocation(ctx context.Context, results []models.AllocationResult) ([]models.ApplicationStatusChange, error) {
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	var changed []models.ApplicationStatusChange
+	for _, result := range results {
+		status := models.ApplicationStatusReleased
+		switch result.Outcome {
+		case models.AllocationKept:
+			continue
+		case models.AllocationWaitlisted:
+			status = models.ApplicationStatusWaitlisted
+		}
+
+		var app models.Application
+		err := tx.QueryRow(ctx, queries.SetAllocationOutcomeQuery, result.ApplicationID, status, now).Scan(
+			&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference,
+			&app.CreatedAt, &app.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, models.ApplicationStatusChange{
+			Application:    app,
+			PreviousStatus: models.ApplicationStatusSelected,
+			Status:         status,
+		})
+	}
+
+	return changed, tx.Commit(ctx)
+}
+
+// summariseAllocation counts outcomes per department
+func summariseAllocation(results []models.AllocationResult, capacities map[string]int) []models.AllocationSummary {
+	summaries := []models.AllocationSummary{}
+	index := map[string]int{}
+	for _, result := range results {
+		i, ok := index[result.Department]
+		if !ok {
+			summary := models.AllocationSummary{Department: result.Department}
+			if capacity, limited := capacities[result.Department]; limited {
+				summary.Capacity = &capacity
+			}
+			summaries = append(summaries, summary)
+			i = len(summaries) - 1
+			index[result.Department] = i
+		}
+		switch result.Outcome {
+		case models.AllocationKept:
+			summaries[i].Kept++
+		case models.AllocationReleased:
+			summaries[i].Released++
+		case models.AllocationWaitlisted:
+			summaries[i].Waitlisted++
+		}
+	}
+	slices.SortFunc(summaries, func(a, b models.AllocationSummary) int {
+		if a.Department < b.Department {
+			return -1
+		}
+		if a.Department > b.Department {
+			return 1
+		}
+		return 0
+	})
+	return summaries
+}
+
+// fetchDepartmentCapacities returns the seat limit of every department that has one
+func fetchDepartmentCapacities(ctx context.Context) (map[string]int, error) {
+	rows, err := services.DB.Query(ctx, queries.GetDepartmentCapacitiesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	capacities := map[string]int{}
+	for rows.Next() {
+		var department string
+		var capacity int
+		if err := rows.Scan(&department, &capacity); err != nil {
+			return nil, err
+		}
+		capacities[department] = capacity
+	}
+	return capacities, rows.Err()
+}
@@ -2,7 +2,12 @@ package routes
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ComputerSocietyVITC/recruitment-backend/models"
@@ -10,6 +15,7 @@ import (
 	"github.com/ComputerSocietyVITC/recruitment-backend/services"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 // PostAnswer handles POST /answers - creates or updates an answer
@@ -31,27 +37,53 @@ func PostAnswer(c *gin.Context) {
 	}
 	userID := userIDInterface.(uuid.UUID)
 
+	expectedVersion, err := parseIfMatchVersion(c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	ctx := context.Background()
 
 	// Verify user owns this application
-	var appUserID uuid.UUID
-	err := services.DB.QueryRow(ctx, queries.CheckApplicationOwnershipQuery, req.ApplicationID).Scan(&appUserID)
+	app := models.Application{ID: req.ApplicationID}
+	err = services.DB.QueryRow(ctx, queries.GetApplicationOwnerStatusQuery, req.ApplicationID).Scan(&app.UserID, &app.Status)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
 		return
 	}
-	if appUserID != userID {
+	if app.UserID != userID {
 		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
 		return
 	}
 
 	// Validate that question department matches application department
 	var appDepartment, questionDepartment string
-	err = services.DB.QueryRow(ctx, queries.ValidateQuestionApplicationDepartmentQuery, req.ApplicationID, req.QuestionID).Scan(&appDepartment, &questionDepartment)
+	var domainApplicable bool
+	var questionType string
+	var options []string
+	var roundAdmitted, firstRound bool
+	err = services.DB.QueryRow(ctx, queries.ValidateQuestionApplicationDepartmentQuery, req.ApplicationID, req.QuestionID).Scan(
+		&appDepartment, &questionDepartment, &domainApplicable, &questionType, &options, &roundAdmitted, &firstRound,
+	)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Application or question not found"})
 		return
 	}
+
+	// Application form answers can only change while drafting; later rounds are answered while the application is under review
+	if firstRound && app.Status != models.ApplicationStatusDraft {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+		return
+	}
+	if !firstRound && (!app.Reviewable() || app.Status == models.ApplicationStatusRejected || app.Status == models.ApplicationStatusReleased) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Application is no longer under review"})
+		return
+	}
+	if !roundAdmitted {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Question belongs to a round this application has not been admitted to"})
+		return
+	}
 	if appDepartment != questionDepartment {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": "Question department does not match application department",
@@ -62,22 +94,25 @@ func PostAnswer(c *gin.Context) {
 		})
 		return
 	}
+	if !domainApplicable {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Question belongs to a domain this application did not pick"})
+		return
+	}
+	if err := models.ValidateAnswerBody(questionType, options, req.Body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid answer", "details": err.Error()})
+		return
+	}
 
-	// Upsert the answer
-	var answer models.Answer
-	err = services.DB.QueryRow(ctx, queries.UpsertAnswerQuery,
-		uuid.New(),
-		req.ApplicationID,
-		userID,
-		req.QuestionID,
-		req.Body,
-		time.Now(),
-		time.Now(),
-	).Scan(
-		&answer.ID, &answer.ApplicationID, &userID, &answer.QuestionID,
-		&answer.Body, &answer.CreatedAt, &answer.UpdatedAt,
-	)
-
+	// Upsert the answer, rejecting the write if another tab saved since the client loaded it
+	answer, err := saveAnswer(ctx, services.DB, req.ApplicationID, userID, req.QuestionID, req.Body, expectedVersion)
+	if errors.Is(err, errAnswerVersionConflict) {
+		c.Header("ETag", answerETag(answer.Version))
+		c.JSON(http.StatusConflict, gin.H{
+			"error":  err.Error(),
+			"answer": answer,
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to save answer",
@@ -86,6 +121,7 @@ func PostAnswer(c *gin.Context) {
 		return
 	}
 
+	c.Header("ETag", answerETag(answer.Version))
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Answer saved successfully",
 		"answer":  answer,
@@ -117,7 +153,7 @@ func DeleteAnswer(c *gin.Context) {
 	var answerUserID uuid.UUID
 	err = services.DB.QueryRow(ctx, queries.GetAnswerByIDQuery, answerID).Scan(
 		&answer.ID, &answer.ApplicationID, &answerUserID, &answer.QuestionID,
-		&answer.Body, &answer.CreatedAt, &answer.UpdatedAt,
+		&answer.Body, &answer.Version, &answer.QuestionVersion, &answer.CreatedAt, &answer.UpdatedAt,
 	)
 
 	if err != nil {
@@ -170,6 +206,10 @@ func GetUserAnswersForApplication(c *gin.Context) {
 	}
 	userID := userIDInterface.(uuid.UUID)
 
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+
 	ctx := context.Background()
 
 	// Get answers for the application (with ownership verification)
@@ -190,7 +230,7 @@ func GetUserAnswersForApplication(c *gin.Context) {
 
 		err := rows.Scan(
 			&answer.ID, &answer.ApplicationID, &answerUserID, &answer.QuestionID,
-			&answer.Body, &answer.CreatedAt, &answer.UpdatedAt,
+			&answer.Body, &answer.Version, &answer.QuestionVersion, &answer.CreatedAt, &answer.UpdatedAt,
 		)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
@@ -211,6 +251,16 @@ func GetUserAnswersForApplication(c *gin.Context) {
 		return
 	}
 
+	etag, err := applicationAnswersETag(ctx, services.DB, applicationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to compute answers version",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.Header("ETag", etag)
 	c.JSON(http.StatusOK, gin.H{
 		"message":        "Answers fetched successfully",
 		"answers":        answers,
@@ -219,7 +269,8 @@ func GetUserAnswersForApplication(c *gin.Context) {
 	})
 }
 
-// GetAnswersByUser handles GET /answers/user/:id - gets all answers written by a specific user (admin/evaluator only)
+// GetAnswersByUser handles GET /answers/user/:id - gets answers written by a specific user with filtering,
+// sorting and cursor pagination (admin/evaluator only)
 func GetAnswersByUser(c *gin.Context) {
 	// Get user ID from URL
 	targetUserIDStr := c.Param("id")
@@ -229,10 +280,41 @@ func GetAnswersByUser(c *gin.Context) {
 		return
 	}
 
+	page, err := parsePageRequest(c, queries.AnswerSortFields, "created_at", true)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pagination parameters", "details": err.Error()})
+		return
+	}
+
+	// Looking answers up by applicant would defeat blind review, so blind-reviewed applications are left out,
+	// as are departments the evaluator does not review
+	list := queries.NewListQuery(queries.ListAnswersColumns, queries.ListAnswersFrom, "a.id").
+		Where("a.user_id = ?", targetUserID).
+		Where("NOT application_blind_review(a.application_id)").
+		Where("evaluator_reviews_department(?::uuid, q.department)", reviewScope(c))
+	if raw := c.Query("application_id"); raw != "" {
+		applicationID, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application_id filter"})
+			return
+		}
+		list.Where("a.application_id = ?", applicationID)
+	}
+	err = errors.Join(
+		filterDepartment(c, list, "q.department"),
+		filterCreatedRange(c, list, "a.created_at"),
+	)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filter parameters", "details": err.Error()})
+		return
+	}
+	filterSearch(c, list, "a.body")
+
+	sql, args := list.Build(page.Sort, page.Desc, page.After, page.Limit)
+
 	ctx := context.Background()
 
-	// Get all answers by the specified user
-	rows, err := services.DB.Query(ctx, queries.GetAnswersByUserQuery, targetUserID)
+	rows, err := services.DB.Query(ctx, sql, args...)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to fetch answers",
@@ -242,13 +324,15 @@ func GetAnswersByUser(c *gin.Context) {
 	}
 	defer rows.Close()
 
-	var answers []models.Answer
+	answers := []models.Answer{}
+	var keys []string
 	for rows.Next() {
 		var answer models.Answer
+		var key string
 
 		err := rows.Scan(
 			&answer.ID, &answer.ApplicationID, &targetUserID, &answer.QuestionID,
-			&answer.Body, &answer.CreatedAt, &answer.UpdatedAt,
+			&answer.Body, &answer.Version, &answer.QuestionVersion, &answer.CreatedAt, &answer.UpdatedAt, &key,
 		)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
@@ -259,6 +343,7 @@ func GetAnswersByUser(c *gin.Context) {
 		}
 
 		answers = append(answers, answer)
+		keys = append(keys, key)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -269,10 +354,207 @@ func GetAnswersByUser(c *gin.Context) {
 		return
 	}
 
+	answers, nextCursor := paginate(page, answers, keys, func(a models.Answer) uuid.UUID { return a.ID })
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "User answers fetched successfully",
+		"answers":     answers,
+		"count":       len(answers),
+		"user_id":     targetUserID,
+		"limit":       page.Limit,
+		"next_cursor": nextCursor,
+	})
+}
+
+// GetAnswerRevisions handles GET /answers/:id/revisions - lists every saved version of an answer (owner or evaluator+)
+func GetAnswerRevisions(c *gin.Context) {
+	answerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid answer ID"})
+		return
+	}
+
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+	role, _ := c.MustGet("userRole").(models.UserRole)
+
+	ctx := context.Background()
+
+	var answer models.Answer
+	var answerUserID uuid.UUID
+	err = services.DB.QueryRow(ctx, queries.GetAnswerByIDQuery, answerID).Scan(
+		&answer.ID, &answer.ApplicationID, &answerUserID, &answer.QuestionID,
+		&answer.Body, &answer.Version, &answer.QuestionVersion, &answer.CreatedAt, &answer.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Answer not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch answer",
+			"details": err.Error(),
+		})
+		return
+	}
+	if answerUserID != userID && role == models.RoleApplicant {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+	if answerUserID != userID {
+		// Staff see the same answers as through GetAnswersByUser: submitted ones outside blind review,
+		// where earlier drafts could give the applicant away
+		if !requireReviewableApplication(c, answer.ApplicationID) {
+			return
+		}
+		var application models.Application
+		var blind bool
+		err = services.DB.QueryRow(ctx, queries.GetApplicationByIDQuery, answer.ApplicationID).Scan(
+			&application.ID, &application.UserID, &application.Department, &application.Submitted, &application.Status, &application.Preference,
+			&application.CreatedAt, &application.UpdatedAt,
+		)
+		if err == nil {
+			err = services.DB.QueryRow(ctx, queries.ApplicationBlindReviewQuery, answer.ApplicationID).Scan(&blind)
+		}
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to fetch application",
+				"details": err.Error(),
+			})
+			return
+		}
+		if !application.Reviewable() {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Answer not found"})
+			return
+		}
+		if blind {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Answer history is hidden while the application is under blind review"})
+			return
+		}
+	}
+
+	rows, err := services.DB.Query(ctx, queries.GetAnswerRevisionsQuery, answerID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch revisions",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	revisions := []models.AnswerRevision{}
+	for rows.Next() {
+		var revision models.AnswerRevision
+		if err := rows.Scan(&revision.ID, &revision.AnswerID, &revision.Version, &revision.Body, &revision.CreatedAt); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan revision",
+				"details": err.Error(),
+			})
+			return
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading revisions",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.Header("ETag", answerETag(answer.Version))
 	c.JSON(http.StatusOK, gin.H{
-		"message": "User answers fetched successfully",
-		"answers": answers,
-		"count":   len(answers),
-		"user_id": targetUserID,
+		"message":   "Answer revisions fetched successfully",
+		"answer":    answer,
+		"revisions": revisions,
+		"count":     len(revisions),
 	})
 }
+
+// errAnswerVersionConflict is returned by saveAnswer when the answer changed after the client loaded it
+var errAnswerVersionConflict = errors.New("answer has been modified since it was loaded")
+
+// answerQuerier is satisfied by both the connection pool and a transaction
+type answerQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// saveAnswer upserts an answer, recording a revision when its text changes.
+// If expectedVersion is set and the stored answer has moved past it with different text,
+// errAnswerVersionConflict is returned together with the current answer.
+func saveAnswer(ctx context.Context, db answerQuerier, applicationID, userID, questionID uuid.UUID, body string, expectedVersion *int) (models.Answer, error) {
+	var answer models.Answer
+	var answerUserID uuid.UUID
+	now := time.Now()
+
+	err := db.QueryRow(ctx, queries.UpsertAnswerQuery,
+		uuid.New(), applicationID, userID, questionID, body, now, now, expectedVersion,
+	).Scan(
+		&answer.ID, &answer.ApplicationID, &answerUserID, &answer.QuestionID,
+		&answer.Body, &answer.Version, &answer.QuestionVersion, &answer.CreatedAt, &answer.UpdatedAt,
+	)
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return answer, err
+	}
+
+	// Nothing was written: the text is unchanged or the client's version is stale
+	err = db.QueryRow(ctx, queries.GetAnswerByApplicationQuestionQuery, applicationID, questionID).Scan(
+		&answer.ID, &answer.ApplicationID, &answerUserID, &answer.QuestionID,
+		&answer.Body, &answer.Version, &answer.QuestionVersion, &answer.CreatedAt, &answer.UpdatedAt,
+	)
+	if err != nil {
+		return answer, err
+	}
+	if expectedVersion != nil && answer.Version != *expectedVersion && answer.Body != body {
+		return answer, errAnswerVersionConflict
+	}
+	return answer, nil
+}
+
+// answerETag formats an answer version as an ETag
+func answerETag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// parseIfMatchVersion reads the answer version from an If-Match header; nil means no precondition
+func parseIfMatchVersion(header string) (*int, error) {
+	header = strings.TrimPrefix(strings.TrimSpace(header), "W/")
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	version, err := strconv.Atoi(strings.Trim(header, `"`))
+	if err != nil || version < 1 {
+		return nil, errors.New("If-Match must be an answer ETag such as \"3\"")
+	}
+	return &version, nil
+}
+
+// applicationAnswersETag derives an ETag covering the versions of all answers in an application
+func applicationAnswersETag(ctx context.Context, db answerQuerier, applicationID uuid.UUID) (string, error) {
+	rows, err := db.Query(ctx, queries.GetApplicationAnswerVersionsQuery, applicationID)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	h := sha256.New()
+	for rows.Next() {
+		var questionID uuid.UUID
+		var version int
+		if err := rows.Scan(&questionID, &version); err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s:%d\n", questionID, version)
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`"%x"`, h.Sum(nil)[:12]), nil
+}
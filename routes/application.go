@@ -2,8 +2,10 @@ package routes
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,11 +18,65 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
-// GetAllApplications fetches all applications
+// GetAllApplications handles GET /applications - lists applications with filtering, sorting and cursor pagination
 func GetAllApplications(c *gin.Context) {
-	ctx := context.Background()
+	page, err := parsePageRequest(c, queries.ApplicationSortFields, "created_at", true)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pagination parameters", "details": err.Error()})
+		return
+	}
 
-	rows, err := services.DB.Query(ctx, queries.GetAllApplicationsQuery)
+	list := queries.NewListQuery(queries.ListApplicationsColumns, queries.ListApplicationsFrom, "app.id")
+	if raw := c.Query("user_id"); raw != "" {
+		userID, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user_id filter"})
+			return
+		}
+		list.Where("app.user_id = ?", userID)
+	}
+	if status := c.Query("status"); status != "" {
+		if !slices.Contains(models.ApplicationStatuses, status) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status filter", "statuses": models.ApplicationStatuses})
+			return
+		}
+		list.Where("app.status = ?", status)
+	}
+	if domain := c.Query("domain"); domain != "" {
+		list.Where("EXISTS (SELECT 1 FROM application_domains ad WHERE ad.application_id = app.id AND ad.domain = ?)", domain)
+	}
+	if raw := c.Query("tag"); raw != "" {
+		tag, err := models.NormaliseTag(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag filter", "details": err.Error()})
+			return
+		}
+		list.Where("EXISTS (SELECT 1 FROM application_tags t WHERE t.application_id = app.id AND t.tag = ?)", tag)
+	}
+	if raw := c.Query("round"); raw != "" {
+		roundID, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round filter"})
+			return
+		}
+		list.Where("EXISTS (SELECT 1 FROM application_rounds ar WHERE ar.application_id = app.id AND ar.round_id = ?)", roundID)
+	}
+	err = errors.Join(
+		filterDepartment(c, list, "app.department"),
+		filterBool(c, list, "submitted", "app.submitted"),
+		filterBool(c, list, "chickened_out", "u.chickened_out"),
+		filterCreatedRange(c, list, "app.created_at"),
+	)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filter parameters", "details": err.Error()})
+		return
+	}
+	filterSearch(c, list, "u.full_name", "u.email", "u.reg_num")
+
+	sql, args := list.Build(page.Sort, page.Desc, page.After, page.Limit)
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, sql, args...)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to fetch applications",
@@ -30,14 +86,15 @@ func GetAllApplications(c *gin.Context) {
 	}
 	defer rows.Close()
 
-	var applications []models.Application
+	applications := []models.Application{}
+	var keys []string
 	for rows.Next() {
 		var app models.Application
+		var key string
 
-		// Updated scan to match actual database columns: id, user_id, department, submitted, created_at, updated_at
 		err := rows.Scan(
-			&app.ID, &app.UserID, &app.Department, &app.Submitted,
-			&app.CreatedAt, &app.UpdatedAt,
+			&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference,
+			&app.CreatedAt, &app.UpdatedAt, &app.Domains, &key,
 		)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
@@ -47,6 +104,7 @@ func GetAllApplications(c *gin.Context) {
 			return
 		}
 		applications = append(applications, app)
+		keys = append(keys, key)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -57,10 +115,14 @@ func GetAllApplications(c *gin.Context) {
 		return
 	}
 
+	applications, nextCursor := paginate(page, applications, keys, func(a models.Application) uuid.UUID { return a.ID })
+
 	c.JSON(http.StatusOK, gin.H{
 		"message":      "Applications fetched successfully",
 		"applications": applications,
 		"count":        len(applications),
+		"limit":        page.Limit,
+		"next_cursor":  nextCursor,
 	})
 }
 
@@ -88,6 +150,35 @@ func CreateApplication(c *gin.Context) {
 		return
 	}
 
+	exists, active, err := departmentStatus(c.Request.Context(), req.Department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to validate department",
+			"details": err.Error(),
+		})
+		return
+	}
+	if !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department", "department": req.Department})
+		return
+	}
+	if !active {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "This department is not accepting applications", "department": req.Department})
+		return
+	}
+	domains, err := validateDepartmentDomains(c.Request.Context(), req.Department, req.Domains)
+	if err != nil {
+		if errors.Is(err, errInvalidDomains) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid domain for this department", "domains": req.Domains})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to validate domains",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	application := models.Application{
 		ID:         uuid.New(),
 		UserID:     userID,
@@ -102,7 +193,7 @@ func CreateApplication(c *gin.Context) {
 	// Check if user has reached the maximum number of applications
 	maxApplications := utils.GetEnvAsInt("MAXIMUM_APPLICATIONS_PER_USER", 2) // Default to 3 if not set
 	var currentCount int
-	err := services.DB.QueryRow(ctx, queries.CountUserApplicationsQuery, userID).Scan(&currentCount)
+	err = services.DB.QueryRow(ctx, queries.CountUserApplicationsQuery, userID).Scan(&currentCount)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to check application count",
@@ -123,13 +214,33 @@ func CreateApplication(c *gin.Context) {
 		return
 	}
 
-	err = services.DB.QueryRow(ctx, queries.CreateApplicationQuery,
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to start transaction",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, queries.CreateApplicationQuery,
 		application.ID, application.UserID, application.Department,
 		application.Submitted, application.CreatedAt, application.UpdatedAt,
 	).Scan(
 		&application.ID, &application.UserID, &application.Department,
-		&application.Submitted, &application.CreatedAt, &application.UpdatedAt,
+		&application.Submitted, &application.Status, &application.Preference, &application.CreatedAt, &application.UpdatedAt,
 	)
+	if err == nil && len(domains) > 0 {
+		_, err = tx.Exec(ctx, queries.InsertApplicationDomainsQuery, application.ID, application.Department, domains)
+		application.Domains = domains
+	}
+	if err == nil {
+		_, err = tx.Exec(ctx, queries.AdmitApplicationToFirstRoundQuery, application.ID, application.Department, application.CreatedAt)
+	}
+	if err == nil {
+		err = tx.Commit(ctx)
+	}
 
 	if err != nil {
 		// Check if this is a unique constraint violation
@@ -154,6 +265,8 @@ func CreateApplication(c *gin.Context) {
 		return
 	}
 
+	services.PublishEvent(models.EventApplicationCreated, application)
+
 	c.JSON(http.StatusCreated, gin.H{
 		"message":     "Application created successfully",
 		"application": application,
@@ -192,7 +305,7 @@ func GetMyApplications(c *gin.Context) {
 
 		// Updated scan to match actual database columns
 		err := rows.Scan(
-			&app.ID, &app.UserID, &app.Department, &app.Submitted,
+			&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference,
 			&app.CreatedAt, &app.UpdatedAt,
 		)
 		if err != nil {
@@ -204,6 +317,64 @@ func GetMyApplications(c *gin.Context) {
 		}
 		applications = append(applications, app)
 	}
+	if err := rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading applications",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	domainRows, err := services.DB.Query(ctx, queries.GetUserApplicationDomainsQuery, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch application domains",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer domainRows.Close()
+	for domainRows.Next() {
+		var applicationID uuid.UUID
+		var domain string
+		if err := domainRows.Scan(&applicationID, &domain); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan application domain",
+				"details": err.Error(),
+			})
+			return
+		}
+		for i := range applications {
+			if applications[i].ID == applicationID {
+				applications[i].Domains = append(applications[i].Domains, domain)
+			}
+		}
+	}
+	if err := domainRows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading application domains",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Applicants only see the rounds they have been admitted to
+	if err := attachApplicationRounds(ctx, userID, applications); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch application rounds",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Decisions stay hidden until the department's results are published
+	if err := maskUnpublishedDecisions(ctx, applications); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to check results publication",
+			"details": err.Error(),
+		})
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"message":      "Your applications fetched successfully",
@@ -255,12 +426,59 @@ func SaveApplication(c *gin.Context) {
 		return
 	}
 
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+
+	// Saves of the same application are serialised so concurrent tabs cannot interleave
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to start transaction",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, queries.LockApplicationForUpdateQuery, applicationID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to lock application",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// If-Match carries the application ETag returned by the last load or save
+	if ifMatch != "" && ifMatch != "*" {
+		current, err := applicationAnswersETag(ctx, tx, applicationID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to compute answers version",
+				"details": err.Error(),
+			})
+			return
+		}
+		if strings.TrimPrefix(ifMatch, "W/") != current {
+			c.Header("ETag", current)
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "Application answers have been modified since they were loaded",
+			})
+			return
+		}
+	}
+
 	// Upsert each answer
+	answers := make([]models.Answer, 0, len(req.Answers))
 	for _, answerReq := range req.Answers {
 		// Validate that question department matches application department
 		var appDepartment, questionDepartment string
+		var domainApplicable bool
+		var questionType string
+		var options []string
+		var roundAdmitted, firstRound bool
 		fmt.Println("Validating question", answerReq.QuestionID, "for application", applicationID)
-		err = services.DB.QueryRow(ctx, queries.ValidateQuestionApplicationDepartmentQuery, applicationID, answerReq.QuestionID).Scan(&appDepartment, &questionDepartment)
+		err = tx.QueryRow(ctx, queries.ValidateQuestionApplicationDepartmentQuery, applicationID, answerReq.QuestionID).Scan(
+			&appDepartment, &questionDepartment, &domainApplicable, &questionType, &options, &roundAdmitted, &firstRound,
+		)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": "Application or question not found",
@@ -282,25 +500,37 @@ func SaveApplication(c *gin.Context) {
 			})
 			return
 		}
-
-		answer := models.Answer{
-			ID:            uuid.New(),
-			ApplicationID: applicationID,
-			QuestionID:    answerReq.QuestionID,
-			Body:          answerReq.Body,
-			CreatedAt:     time.Now(),
-			UpdatedAt:     time.Now(),
+		if !domainApplicable {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Question belongs to a domain this application did not pick",
+				"details": map[string]any{"question_id": answerReq.QuestionID},
+			})
+			return
+		}
+		if !roundAdmitted || !firstRound {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":   "Only application form questions can be saved with the application",
+				"details": map[string]any{"question_id": answerReq.QuestionID},
+			})
+			return
+		}
+		if err := models.ValidateAnswerBody(questionType, options, answerReq.Body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid answer",
+				"details": map[string]any{"question_id": answerReq.QuestionID, "error": err.Error()},
+			})
+			return
 		}
 
-		_, err := services.DB.Exec(ctx, queries.UpsertAnswerQuery,
-			answer.ID,
-			answer.ApplicationID,
-			userID,
-			answer.QuestionID,
-			answer.Body,
-			answer.CreatedAt,
-			answer.UpdatedAt,
-		)
+		answer, err := saveAnswer(ctx, tx, applicationID, userID, answerReq.QuestionID, answerReq.Body, answerReq.Version)
+		if errors.Is(err, errAnswerVersionConflict) {
+			c.JSON(http.StatusConflict, gin.H{
+				"error":       err.Error(),
+				"question_id": answerReq.QuestionID,
+				"answer":      answer,
+			})
+			return
+		}
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
 				"error":   "Failed to save answers",
@@ -308,10 +538,30 @@ func SaveApplication(c *gin.Context) {
 			})
 			return
 		}
+		answers = append(answers, answer)
 	}
 
+	etag, err := applicationAnswersETag(ctx, tx, applicationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to compute answers version",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to save answers",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.Header("ETag", etag)
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Answers saved successfully",
+		"answers": answers,
 	})
 }
 
@@ -335,12 +585,68 @@ func SubmitApplication(c *gin.Context) {
 
 	ctx := context.Background()
 
+	// Required questions must be answered before submitting, unless their conditions hide them
+	rows, err := services.DB.Query(ctx, queries.GetApplicationQuestionAnswersQuery, applicationID, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to check required questions",
+			"details": err.Error(),
+		})
+		return
+	}
+	var questions []models.Question
+	answers := map[uuid.UUID]string{}
+	for rows.Next() {
+		var q models.Question
+		var answer string
+		if err := rows.Scan(&q.ID, &q.Type, &q.Body, &q.Required, &answer); err != nil {
+			rows.Close()
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan required question",
+				"details": err.Error(),
+			})
+			return
+		}
+		questions = append(questions, q)
+		answers[q.ID] = answer
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while checking required questions",
+			"details": err.Error(),
+		})
+		return
+	}
+	if err := attachQuestionConditions(ctx, questions); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to check question conditions",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	visible := models.VisibleQuestions(questions, answers)
+	missing := []gin.H{}
+	for _, q := range questions {
+		if q.Required && visible[q.ID] && strings.TrimSpace(answers[q.ID]) == "" {
+			missing = append(missing, gin.H{"question_id": q.ID, "body": q.Body})
+		}
+	}
+	if len(missing) > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Required questions are unanswered",
+			"missing": missing,
+		})
+		return
+	}
+
 	// Submit the application
 	var application models.Application
 	err = services.DB.QueryRow(ctx, queries.SubmitApplicationQuery,
 		applicationID, time.Now(), userID).Scan(
 		&application.ID, &application.UserID, &application.Department,
-		&application.Submitted, &application.CreatedAt, &application.UpdatedAt,
+		&application.Submitted, &application.Status, &application.Preference, &application.CreatedAt, &application.UpdatedAt,
 	)
 
 	if err != nil {
@@ -351,6 +657,13 @@ func SubmitApplication(c *gin.Context) {
 		return
 	}
 
+	services.PublishEvent(models.EventApplicationSubmitted, application)
+	services.PublishEvent(models.EventApplicationStatusChanged, models.ApplicationStatusChange{
+		Application:    application,
+		PreviousStatus: models.ApplicationStatusDraft,
+		Status:         models.ApplicationStatusSubmitted,
+	})
+
 	c.JSON(http.StatusOK, gin.H{
 		"message":     "Application submitted successfully",
 		"application": application,
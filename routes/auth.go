@@ -125,6 +125,8 @@ func Register(c *gin.Context) {
 
 	services.Mailer <- m
 
+	services.PublishEvent(models.EventUserRegistered, user.ToResponse())
+
 	c.JSON(http.StatusCreated, gin.H{
 		"message": "User created successfully. Please check email for the verification code.",
 		"user":    user.ToResponse(),
@@ -548,7 +550,7 @@ func ResetPassword(c *gin.Context) {
 	})
 }
 
-// ChickenOut handles POST /auth/chicken-out - marks the user as chickened out
+// ChickenOut handles POST /auth/chicken-out - marks the user as chickened out and withdraws their applications
 func ChickenOut(c *gin.Context) {
 	userID, exists := c.Get("userID")
 	if !exists {
@@ -573,8 +575,21 @@ func ChickenOut(c *gin.Context) {
 		return
 	}
 
+	services.PublishEvent(models.EventUserChickenedOut, user.ToResponse())
+
+	// Chickening out withdraws every active application, each reinstatable during the grace period
+	withdrawals, err := withdrawAllApplications(ctx, user)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to withdraw applications",
+			"details": err.Error(),
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"message": "You have successfully chickened out.",
-		"user":    user.ToResponse(),
+		"message":     "You have successfully chickened out.",
+		"user":        user.ToResponse(),
+		"withdrawals": withdrawals,
 	})
 }
@@ -0,0 +1,131 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// RevealApplicant handles POST /admin/applications/:id/reveal - de-anonymises a blind-reviewed applicant (admin+).
+// Every reveal is recorded in the audit log together with the reason given.
+func RevealApplicant(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	var req models.RevealApplicantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	adminID := c.MustGet("userID").(uuid.UUID)
+
+	ctx := context.Background()
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start transaction", "details": err.Error()})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	var user models.User
+	var department string
+	var blind bool
+	err = tx.QueryRow(ctx, queries.GetApplicationApplicantQuery, applicationID).Scan(
+		&user.ID, &user.FullName, &user.Email, &user.RegNum, &user.PhoneNumber, &user.Verified,
+		&user.Role, &user.ChickenedOut, &user.CreatedAt, &user.UpdatedAt,
+		&department, &blind,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch applicant", "details": err.Error()})
+		return
+	}
+
+	// The identity is only handed out once the reveal is on record
+	if _, err := tx.Exec(ctx, queries.CreateApplicantRevealQuery, uuid.New(), applicationID, adminID, req.Reason, time.Now()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record reveal", "details": err.Error()})
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to commit reveal", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"application_id": applicationID,
+		"applicant_code": models.ApplicantCode(applicationID),
+		"department":     department,
+		"blind":          blind,
+		"user":           user.ToResponse(),
+	})
+}
+
+// GetApplicantReveals handles GET /admin/reveals - lists the blind review reveal audit log (?application_id=&limit=)
+func GetApplicantReveals(c *gin.Context) {
+	var applicationID *uuid.UUID
+	if raw := c.Query("application_id"); raw != "" {
+		parsed, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application_id filter"})
+			return
+		}
+		applicationID = &parsed
+	}
+	limit := defaultPageLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit. Must be between 1 and 200"})
+			return
+		}
+		limit = parsed
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetApplicantRevealsQuery, applicationID, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch reveals", "details": err.Error()})
+		return
+	}
+	reveals, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ApplicantReveal, error) {
+		var r models.ApplicantReveal
+		err := row.Scan(&r.ID, &r.ApplicationID, &r.RevealedBy, &r.RevealedByName, &r.Reason, &r.CreatedAt)
+		r.ApplicantCode = models.ApplicantCode(r.ApplicationID)
+		return r, err
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan reveals", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"reveals": reveals,
+		"count":   len(reveals),
+	})
+}
+
+// blindToEvaluator reports whether the caller is an evaluator and the user has an application under blind review,
+// in which case the user must not be resolved for them
+func blindToEvaluator(c *gin.Context, ctx context.Context, userID uuid.UUID) (bool, error) {
+	if role, _ := c.Get("userRole"); role != models.RoleEvaluator {
+		return false, nil
+	}
+	var blind bool
+	err := services.DB.QueryRow(ctx, queries.UserUnderBlindReviewQuery, userID).Scan(&blind)
+	return blind, err
+}
@@ -0,0 +1,131 @@
+package routes
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetScoreCalibration handles GET /admin/reports/calibration - compares evaluators and normalises application scores
+// (?department=&threshold=1.5&format=json|csv&section=applications|evaluators|outliers).
+// JSON returns the whole report; CSV returns one section as a table.
+func GetScoreCalibration(c *gin.Context) {
+	format := c.DefaultQuery("format", "json")
+	section := c.DefaultQuery("section", "applications")
+	department := c.Query("department")
+
+	if format != "json" && format != "csv" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format. Must be one of: json, csv"})
+		return
+	}
+	if section != "applications" && section != "evaluators" && section != "outliers" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid section. Must be one of: applications, evaluators, outliers"})
+		return
+	}
+	threshold := models.DefaultOutlierThreshold
+	if raw := c.Query("threshold"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || parsed <= 0 || parsed > 10 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid threshold. Must be a number above 0 and at most 10"})
+			return
+		}
+		threshold = parsed
+	}
+	if department != "" && !requireDepartment(c, department) {
+		return
+	}
+
+	ctx := context.Background()
+	scores, err := fetchCalibrationScores(ctx, department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch evaluations",
+			"details": err.Error(),
+		})
+		return
+	}
+	report := services.CalibrateScores(scores, threshold)
+	report.Department = department
+
+	if format == "json" {
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Calibration report generated successfully",
+			"report":  report,
+		})
+		return
+	}
+
+	filename := fmt.Sprintf("calibration-%s-%s.csv", section, time.Now().UTC().Format("20060102-150405"))
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	for _, record := range calibrationCSV(report, section) {
+		if err := w.Write(record); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		c.Error(err)
+	}
+}
+
+// calibrationCSV flattens one section of a calibration report into CSV records, header first
+func calibrationCSV(report models.CalibrationReport, section string) [][]string {
+	number := func(f float64) string { return strconv.FormatFloat(f, 'f', 4, 64) }
+
+	var records [][]string
+	switch section {
+	case "evaluators":
+		records = append(records, []string{"evaluator_id", "evaluator_name", "evaluations", "mean", "stddev"})
+		for _, e := range report.Evaluators {
+			records = append(records, []string{
+				e.EvaluatorID.String(), e.EvaluatorName, strconv.Itoa(e.Evaluations), number(e.Mean), number(e.StdDev),
+			})
+		}
+	case "outliers":
+		records = append(records, []string{
+			"evaluation_id", "application_id", "evaluator_id", "evaluator_name", "score", "z_score", "others_mean_z", "deviation",
+		})
+		for _, o := range report.Outliers {
+			records = append(records, []string{
+				o.EvaluationID.String(), o.ApplicationID.String(), o.EvaluatorID.String(), o.EvaluatorName,
+				strconv.Itoa(o.Score), number(o.ZScore), number(o.OthersMeanZ), number(o.Deviation),
+			})
+		}
+	default:
+		records = append(records, []string{"application_id", "department", "evaluations", "raw_mean", "normalised_score"})
+		for _, a := range report.Applications {
+			records = append(records, []string{
+				a.ApplicationID.String(), a.Department, strconv.Itoa(a.Evaluations), number(a.RawMean), number(a.NormalisedScore),
+			})
+		}
+	}
+	return records
+}
+
+// fetchCalibrationScores loads the evaluations of submitted applications, optionally for one department
+func fetchCalibrationScores(ctx context.Context, department string) ([]models.CalibrationScore, error) {
+	rows, err := services.DB.Query(ctx, queries.GetCalibrationScoresQuery, department)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.CalibrationScore, error) {
+		var s models.CalibrationScore
+		err := row.Scan(&s.EvaluationID, &s.ApplicationID, &s.Department, &s.EvaluatorID, &s.EvaluatorName, &s.Score)
+		return s, err
+	})
+}
@@ -0,0 +1,499 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// departmentSlugPattern matches the slugs the departments table accepts
+var departmentSlugPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// errInvalidLeads is returned when a lead is not an admin
+var errInvalidLeads = errors.New("department leads must be admins or super admins")
+
+// errInvalidDomains is returned when a sub-domain does not belong to the department
+var errInvalidDomains = errors.New("domains must belong to the department")
+
+// departmentStatus reports whether a department exists and whether it is accepting applications
+func departmentStatus(ctx context.Context, slug string) (exists, active bool, err error) {
+	err = services.DB.QueryRow(ctx, queries.DepartmentExistsQuery, slug).Scan(&exists, &active)
+	return exists, active, err
+}
+
+// departmentExists reports whether a department exists, active or not
+func departmentExists(ctx context.Context, slug string) (bool, error) {
+	exists, _, err := departmentStatus(ctx, slug)
+	return exists, err
+}
+
+// ListDepartments handles GET /departments - lists the departments currently accepting applications
+func ListDepartments(c *gin.Context) {
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetActiveDepartmentsQuery)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch departments",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	departments := []models.Department{}
+	for rows.Next() {
+		var d models.Department
+		if err := rows.Scan(&d.Slug, &d.Name, &d.Description, &d.Active, &d.Capacity, &d.CreatedAt, &d.UpdatedAt); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan department",
+				"details": err.Error(),
+			})
+			return
+		}
+		departments = append(departments, d)
+	}
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading departments",
+			"details": err.Error(),
+		})
+		return
+	}
+	if err := attachDepartmentDomains(ctx, departments); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch department domains",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"departments": departments,
+		"count":       len(departments),
+	})
+}
+
+// GetAllDepartments handles GET /admin/departments - lists every department with its leads (super admin)
+func GetAllDepartments(c *gin.Context) {
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetAllDepartmentsQuery)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch departments",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	departments := []models.Department{}
+	for rows.Next() {
+		d, err := scanDepartment(rows)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan department",
+				"details": err.Error(),
+			})
+			return
+		}
+		departments = append(departments, d)
+	}
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading departments",
+			"details": err.Error(),
+		})
+		return
+	}
+	if err := attachDepartmentDomains(ctx, departments); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch department domains",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"departments": departments,
+		"count":       len(departments),
+	})
+}
+
+// CreateDepartment handles POST /admin/departments - adds a department (super admin)
+func CreateDepartment(c *gin.Context) {
+	var req models.CreateDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+	if !departmentSlugPattern.MatchString(req.Slug) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Slug must start with a lowercase letter and contain only lowercase letters, digits and underscores"})
+		return
+	}
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	ctx := context.Background()
+	err := saveDepartment(ctx, req.Slug, req.LeadIDs, func(tx pgx.Tx) error {
+		now := time.Now()
+		if _, err := tx.Exec(ctx, queries.CreateDepartmentQuery, req.Slug, req.Name, req.Description, active, req.Capacity, req.BlindReview, now); err != nil {
+			return err
+		}
+		// Every department starts with the application form round
+		_, err := tx.Exec(ctx, queries.CreateRoundQuery, uuid.New(), req.Slug, models.DefaultRoundName, "", now)
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		switch {
+		case errors.Is(err, errInvalidLeads):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.As(err, &pgErr) && pgErr.Code == "23505":
+			c.JSON(http.StatusConflict, gin.H{"error": "A department with this slug already exists"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to create department",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	department, err := fetchDepartment(ctx, req.Slug)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch department",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message":    "Department created successfully",
+		"department": department,
+	})
+}
+
+// UpdateDepartment handles PUT /admin/departments/:slug - replaces a department's settings and leads (super admin)
+func UpdateDepartment(c *gin.Context) {
+	slug := c.Param("slug")
+
+	var req models.UpdateDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	ctx := context.Background()
+	err := saveDepartment(ctx, slug, req.LeadIDs, func(tx pgx.Tx) error {
+		result, err := tx.Exec(ctx, queries.UpdateDepartmentQuery, slug, req.Name, req.Description, req.Active, req.Capacity, req.BlindReview, time.Now())
+		if err != nil {
+			return err
+		}
+		if result.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Department not found"})
+		case errors.Is(err, errInvalidLeads):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to update department",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	department, err := fetchDepartment(ctx, slug)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch department",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Department updated successfully",
+		"department": department,
+	})
+}
+
+// DeleteDepartment handles DELETE /admin/departments/:slug - removes a department nothing refers to (super admin).
+// Departments with questions or applications should be deactivated instead.
+func DeleteDepartment(c *gin.Context) {
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.DeleteDepartmentQuery, c.Param("slug"))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			c.JSON(http.StatusConflict, gin.H{"error": "Department still has questions or applications; deactivate it instead"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to delete department",
+			"details": err.Error(),
+		})
+		return
+	}
+	if result.RowsAffected() == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Department not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Department deleted successfully"})
+}
+
+// saveDepartment runs write in a transaction and then replaces the department's leads
+func saveDepartment(ctx context.Context, slug string, leadIDs []uuid.UUID, write func(tx pgx.Tx) error) error {
+	leadIDs = slices.Clone(leadIDs)
+	slices.SortFunc(leadIDs, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+	leadIDs = slices.Compact(leadIDs)
+
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := write(tx); err != nil {
+		return err
+	}
+
+	if len(leadIDs) > 0 {
+		var admins int
+		if err := tx.QueryRow(ctx, queries.CountAdminUsersQuery, leadIDs).Scan(&admins); err != nil {
+			return err
+		}
+		if admins != len(leadIDs) {
+			return errInvalidLeads
+		}
+	}
+
+	if _, err := tx.Exec(ctx, queries.DeleteDepartmentLeadsQuery, slug); err != nil {
+		return err
+	}
+	if len(leadIDs) > 0 {
+		if _, err := tx.Exec(ctx, queries.InsertDepartmentLeadsQuery, slug, leadIDs); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// scanDepartment scans a row selected with the department columns, including leads
+func scanDepartment(row pgx.Row) (models.Department, error) {
+	var d models.Department
+	err := row.Scan(&d.Slug, &d.Name, &d.Description, &d.Active, &d.Capacity, &d.BlindReview, &d.LeadIDs, &d.CreatedAt, &d.UpdatedAt)
+	return d, err
+}
+
+// requireDepartment responds with an error and returns false unless slug names an existing department
+func requireDepartment(c *gin.Context, slug string) bool {
+	exists, err := departmentExists(c.Request.Context(), slug)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to validate department",
+			"details": err.Error(),
+		})
+		return false
+	}
+	if !exists {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department", "department": slug})
+		return false
+	}
+	return true
+}
+
+// CreateDepartmentDomain handles POST /admin/departments/:slug/domains - adds a sub-domain to a department (admin+)
+func CreateDepartmentDomain(c *gin.Context) {
+	department := c.Param("slug")
+
+	var req models.CreateDepartmentDomainRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+	if !departmentSlugPattern.MatchString(req.Slug) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Slug must start with a lowercase letter and contain only lowercase letters, digits and underscores"})
+		return
+	}
+
+	ctx := context.Background()
+	var domain models.DepartmentDomain
+	err := services.DB.QueryRow(ctx, queries.CreateDepartmentDomainQuery, department, req.Slug, req.Name, req.Description, time.Now()).Scan(
+		&domain.Department, &domain.Slug, &domain.Name, &domain.Description, &domain.CreatedAt, &domain.UpdatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		switch {
+		case errors.As(err, &pgErr) && pgErr.Code == "23503":
+			c.JSON(http.StatusNotFound, gin.H{"error": "Department not found"})
+		case errors.As(err, &pgErr) && pgErr.Code == "23505":
+			c.JSON(http.StatusConflict, gin.H{"error": "This department already has a domain with this slug"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to create domain",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Domain created successfully",
+		"domain":  domain,
+	})
+}
+
+// UpdateDepartmentDomain handles PUT /admin/departments/:slug/domains/:domain - renames a sub-domain (admin+)
+func UpdateDepartmentDomain(c *gin.Context) {
+	var req models.UpdateDepartmentDomainRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	ctx := context.Background()
+	var domain models.DepartmentDomain
+	err := services.DB.QueryRow(ctx, queries.UpdateDepartmentDomainQuery, c.Param("slug"), c.Param("domain"), req.Name, req.Description, time.Now()).Scan(
+		&domain.Department, &domain.Slug, &domain.Name, &domain.Description, &domain.CreatedAt, &domain.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Domain not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update domain",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Domain updated successfully",
+		"domain":  domain,
+	})
+}
+
+// DeleteDepartmentDomain handles DELETE /admin/departments/:slug/domains/:domain - removes a sub-domain no question targets (admin+).
+// Applications that picked it keep their department but lose the domain.
+func DeleteDepartmentDomain(c *gin.Context) {
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.DeleteDepartmentDomainQuery, c.Param("slug"), c.Param("domain"))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			c.JSON(http.StatusConflict, gin.H{"error": "Questions still target this domain; move or delete them first"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to delete domain",
+			"details": err.Error(),
+		})
+		return
+	}
+	if result.RowsAffected() == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Domain not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Domain deleted successfully"})
+}
+
+// fetchDepartment loads a department with its leads and sub-domains
+func fetchDepartment(ctx context.Context, slug string) (models.Department, error) {
+	department, err := scanDepartment(services.DB.QueryRow(ctx, queries.GetDepartmentBySlugQuery, slug))
+	if err != nil {
+		return department, err
+	}
+	departments := []models.Department{department}
+	err = attachDepartmentDomains(ctx, departments)
+	return departments[0], err
+}
+
+// attachDepartmentDomains fills in the sub-domains of each department
+func attachDepartmentDomains(ctx context.Context, departments []models.Department) error {
+	rows, err := services.DB.Query(ctx, queries.GetAllDepartmentDomainsQuery)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	byDepartment := map[string][]models.DepartmentDomain{}
+	for rows.Next() {
+		var domain models.DepartmentDomain
+		if err := rows.Scan(&domain.Department, &domain.Slug, &domain.Name, &domain.Description, &domain.CreatedAt, &domain.UpdatedAt); err != nil {
+			return err
+		}
+		byDepartment[domain.Department] = append(byDepartment[domain.Department], domain)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range departments {
+		departments[i].Domains = byDepartment[departments[i].Slug]
+		if departments[i].Domains == nil {
+			departments[i].Domains = []models.DepartmentDomain{}
+		}
+	}
+	return nil
+}
+
+// validateDepartmentDomains checks that every slug is a sub-domain of the department, returning them de-duplicated
+func validateDepartmentDomains(ctx context.Context, department string, slugs []string) ([]string, error) {
+	unique := []string{}
+	for _, slug := range slugs {
+		if !slices.Contains(unique, slug) {
+			unique = append(unique, slug)
+		}
+	}
+	if len(unique) == 0 {
+		return unique, nil
+	}
+
+	var count int
+	if err := services.DB.QueryRow(ctx, queries.CountDepartmentDomainsQuery, department, unique).Scan(&count); err != nil {
+		return nil, err
+	}
+	if count != len(unique) {
+		return nil, errInvalidDomains
+	}
+	return unique, nil
+}
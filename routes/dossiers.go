@@ -0,0 +1,257 @@
+package routes
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// dossierLinkPattern finds links applicants pasted into their answers (portfolios, repositories, drives)
+var dossierLinkPattern = regexp.MustCompile(`https?://[^\s<>"')\]]+`)
+
+// dossierFilenamePattern strips characters that are unsafe in file names
+var dossierFilenamePattern = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// GetApplicationDossier handles GET /applications/:id/dossier.pdf - renders an applicant dossier as PDF (evaluator+)
+func GetApplicationDossier(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+
+	doc, filename, err := buildDossier(c.Request.Context(), applicationID)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to build dossier",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Type", "application/pdf")
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, filename))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+	doc.WriteTo(c.Writer)
+}
+
+// GetDepartmentDossiers handles GET /applications/dossiers.zip?department=&domain= - zips the dossiers of a department's
+// submitted applications, optionally only those that picked a sub-domain
+func GetDepartmentDossiers(c *gin.Context) {
+	department := c.Query("department")
+	domain := c.Query("domain")
+	if !requireDepartment(c, department) || !requireReviewableDepartment(c, department) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	rows, err := services.DB.Query(ctx, queries.GetSubmittedApplicationIDsByDepartmentQuery, department, domain)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch applications",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	var applicationIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan application ID",
+				"details": err.Error(),
+			})
+			return
+		}
+		applicationIDs = append(applicationIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading applications",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	scope := department
+	if domain != "" {
+		scope += "-" + domain
+	}
+	filename := fmt.Sprintf("dossiers-%s-%s.zip", dossierFilenamePattern.ReplaceAllString(scope, "_"), time.Now().UTC().Format("20060102"))
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+
+	// Dossiers are rendered one at a time straight into the zip stream
+	zw := zip.NewWriter(c.Writer)
+	for _, id := range applicationIDs {
+		doc, name, err := buildDossier(ctx, id)
+		if err != nil {
+			c.Error(err)
+			continue
+		}
+		fw, err := zw.Create(name)
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+		if _, err := doc.WriteTo(fw); err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+		zw.Flush()
+		c.Writer.Flush()
+	}
+	zw.Close()
+}
+
+// buildDossier renders the profile, answers, links and evaluations of an application
+func buildDossier(ctx context.Context, applicationID uuid.UUID) (*utils.PDFDocument, string, error) {
+	var app models.Application
+	var user models.User
+	var blind bool
+	err := services.DB.QueryRow(ctx, queries.GetDossierApplicationQuery, applicationID).Scan(
+		&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference, &app.CreatedAt, &app.UpdatedAt,
+		&user.ID, &user.FullName, &user.Email, &user.RegNum, &user.PhoneNumber, &user.Verified,
+		&user.Role, &user.ChickenedOut, &user.CreatedAt, &user.UpdatedAt,
+		&blind,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	profile := user.ToResponse()
+
+	// Blind-reviewed dossiers carry the applicant code instead of any contact details
+	title := profile.FullName
+	if blind {
+		title = "Applicant " + models.ApplicantCode(app.ID)
+	}
+
+	doc := utils.NewPDFDocument(fmt.Sprintf("Dossier - %s (%s)", title, app.Department))
+	doc.Heading(title)
+	doc.KeyValue("Department", app.Department)
+	doc.KeyValue("Status", app.Status)
+	if err := services.DB.QueryRow(ctx, queries.GetApplicationDomainsQuery, applicationID).Scan(&app.Domains); err != nil {
+		return nil, "", err
+	}
+	if len(app.Domains) > 0 {
+		doc.KeyValue("Domains", strings.Join(app.Domains, ", "))
+	}
+	if app.Preference != nil {
+		doc.KeyValue("Preference", fmt.Sprintf("Choice %d", *app.Preference))
+	} else {
+		doc.KeyValue("Preference", "Not ranked")
+	}
+	if !blind {
+		doc.KeyValue("Registration number", profile.RegNum)
+		doc.KeyValue("Email", profile.Email)
+		doc.KeyValue("Phone", profile.PhoneNumber)
+	}
+	doc.KeyValue("Verified", strconv.FormatBool(profile.Verified))
+	doc.KeyValue("Chickened out", strconv.FormatBool(profile.ChickenedOut))
+	doc.KeyValue("Applied on", app.CreatedAt.UTC().Format("02 Jan 2006 15:04 MST"))
+	doc.KeyValue("Application ID", app.ID.String())
+
+	doc.Subheading("Answers")
+	rows, err := services.DB.Query(ctx, queries.GetDossierAnswersQuery, applicationID)
+	if err != nil {
+		return nil, "", err
+	}
+	var links []string
+	number := 0
+	for rows.Next() {
+		var questionID uuid.UUID
+		var question, answer string
+		if err := rows.Scan(&questionID, &question, &answer); err != nil {
+			rows.Close()
+			return nil, "", err
+		}
+		number++
+		doc.Paragraph(fmt.Sprintf("Q%d. %s", number, question))
+		if strings.TrimSpace(answer) == "" {
+			answer = "(not answered)"
+		}
+		doc.IndentedParagraph(answer, 15)
+
+		for _, link := range dossierLinkPattern.FindAllString(answer, -1) {
+			if !slices.Contains(links, link) {
+				links = append(links, link)
+			}
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	if number == 0 {
+		doc.Paragraph("No questions have been set for this department.")
+	}
+
+	doc.Subheading("Links")
+	if len(links) == 0 {
+		doc.Paragraph("No links were provided.")
+	}
+	for _, link := range links {
+		doc.Paragraph(link)
+	}
+
+	doc.Subheading("Evaluations")
+	rows, err = services.DB.Query(ctx, queries.GetDossierEvaluationsQuery, applicationID)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+	evaluations := 0
+	for rows.Next() {
+		var evaluator, notes string
+		var score int
+		var updatedAt time.Time
+		if err := rows.Scan(&evaluator, &score, &notes, &updatedAt); err != nil {
+			return nil, "", err
+		}
+		evaluations++
+		doc.KeyValue(evaluator, fmt.Sprintf("%d/10 (%s)", score, updatedAt.UTC().Format("02 Jan 2006")))
+		if strings.TrimSpace(notes) != "" {
+			doc.IndentedParagraph(notes, 15)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+	if evaluations == 0 {
+		doc.Paragraph("Not evaluated yet.")
+	}
+
+	name := dossierFilenamePattern.ReplaceAllString(fmt.Sprintf("%s-%s", profile.RegNum, profile.FullName), "_")
+	if blind {
+		name = models.ApplicantCode(app.ID)
+	}
+	return doc, fmt.Sprintf("%s-%s.pdf", name, app.Department), nil
+}
@@ -0,0 +1,141 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RecordEvaluation handles POST /applications/:id/evaluations - records the current evaluator's score for an application
+func RecordEvaluation(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+
+	var req models.RecordEvaluationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	evaluatorID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+
+	// Only submitted applications can be evaluated
+	var application models.Application
+	err = services.DB.QueryRow(ctx, queries.GetApplicationByIDQuery, applicationID).Scan(
+		&application.ID, &application.UserID, &application.Department, &application.Submitted, &application.Status, &application.Preference,
+		&application.CreatedAt, &application.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch application",
+			"details": err.Error(),
+		})
+		return
+	}
+	if !application.Reviewable() {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Only submitted applications can be evaluated"})
+		return
+	}
+
+	var evaluation models.Evaluation
+	err = services.DB.QueryRow(ctx, queries.UpsertEvaluationQuery,
+		applicationID, evaluatorID, *req.Score, req.Notes,
+	).Scan(
+		&evaluation.ID, &evaluation.ApplicationID, &evaluation.EvaluatorID, &evaluation.Score,
+		&evaluation.Notes, &evaluation.CreatedAt, &evaluation.UpdatedAt,
+	)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to record evaluation",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	services.PublishEvent(models.EventEvaluationRecorded, evaluation)
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Evaluation recorded successfully",
+		"evaluation": evaluation,
+	})
+}
+
+// GetApplicationEvaluations handles GET /applications/:id/evaluations - fetches all evaluations of an application
+func GetApplicationEvaluations(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetApplicationEvaluationsQuery, applicationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch evaluations",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	var evaluations []models.Evaluation
+	for rows.Next() {
+		var evaluation models.Evaluation
+		err := rows.Scan(
+			&evaluation.ID, &evaluation.ApplicationID, &evaluation.EvaluatorID, &evaluation.Score,
+			&evaluation.Notes, &evaluation.CreatedAt, &evaluation.UpdatedAt,
+		)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan evaluation data",
+				"details": err.Error(),
+			})
+			return
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading evaluations",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":        "Evaluations fetched successfully",
+		"evaluations":    evaluations,
+		"count":          len(evaluations),
+		"application_id": applicationID,
+	})
+}
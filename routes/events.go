@@ -0,0 +1,71 @@
+package routes
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// StreamEvents handles GET /events/stream - pushes live recruitment events over Server-Sent Events
+func StreamEvents(c *gin.Context) {
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	role, ok := c.MustGet("userRole").(models.UserRole)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user role format"})
+		return
+	}
+
+	client, unsubscribe, err := services.SubscribeStream(c.Request.Context(), userID, role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to subscribe to events",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer unsubscribe()
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // Disable proxy buffering (nginx)
+	c.Status(http.StatusOK)
+
+	heartbeat := time.NewTicker(utils.GetEnvAsDuration("SSE_HEARTBEAT_INTERVAL", 25*time.Second))
+	defer heartbeat.Stop()
+
+	// Tell the client the stream is live before any event arrives
+	fmt.Fprint(c.Writer, "retry: 5000\n: connected\n\n")
+	c.Writer.Flush()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			return true
+		case event := <-client.Events:
+			data, err := json.Marshal(event)
+			if err != nil {
+				return true
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+			return true
+		}
+	})
+}
@@ -0,0 +1,284 @@
+package routes
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5"
+)
+
+// exportRow is a single application with its applicant and answers keyed by question ID
+type exportRow struct {
+	ApplicationID string              `json:"application_id"`
+	Department    string              `json:"department"`
+	Status        string              `json:"status"`
+	SubmittedAt   *time.Time          `json:"submitted_at"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+	User          models.UserResponse `json:"user"`
+	Answers       map[string]string   `json:"answers"`
+}
+
+// exportWriter writes export rows in a specific file format
+type exportWriter interface {
+	WriteHeader(questions []models.Question) error
+	WriteRow(row exportRow, questions []models.Question) error
+	Flush() error
+	Close() error
+}
+
+// exportFixedColumns are the per-application columns preceding the pivoted question columns
+var exportFixedColumns = []string{
+	"application_id", "department", "status", "submitted_at", "created_at",
+	"user_id", "full_name", "email", "reg_num", "phone_number", "verified", "chickened_out",
+}
+
+// exportCells flattens an export row into spreadsheet cells, one per question after the fixed columns
+func exportCells(row exportRow, questions []models.Question) []string {
+	submittedAt := ""
+	if row.SubmittedAt != nil {
+		submittedAt = row.SubmittedAt.UTC().Format(time.RFC3339)
+	}
+
+	cells := []string{
+		row.ApplicationID, row.Department, row.Status, submittedAt, row.CreatedAt.UTC().Format(time.RFC3339),
+		row.User.ID.String(), row.User.FullName, row.User.Email, row.User.RegNum, row.User.PhoneNumber,
+		strconv.FormatBool(row.User.Verified), strconv.FormatBool(row.User.ChickenedOut),
+	}
+	for _, q := range questions {
+		cells = append(cells, row.Answers[q.ID.String()])
+	}
+	return cells
+}
+
+// exportHeader returns the header row, using each question's text as its column title
+func exportHeader(questions []models.Question) []string {
+	header := slices.Clone(exportFixedColumns)
+	for _, q := range questions {
+		scope := q.Department
+		if q.Domain != nil {
+			scope += "/" + *q.Domain
+		}
+		header = append(header, fmt.Sprintf("[%s] %s", scope, q.Body))
+	}
+	return header
+}
+
+// spreadsheetSafe neutralises cells a spreadsheet would evaluate as a formula by prefixing them with a quote.
+// Applicants control most of the exported text, so it must never run when the file is opened.
+func spreadsheetSafe(cells []string) []string {
+	for i, cell := range cells {
+		if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+			cells[i] = "'" + cell
+		}
+	}
+	return cells
+}
+
+type csvExportWriter struct{ w *csv.Writer }
+
+func (e *csvExportWriter) WriteHeader(questions []models.Question) error {
+	return e.w.Write(spreadsheetSafe(exportHeader(questions)))
+}
+
+func (e *csvExportWriter) WriteRow(row exportRow, questions []models.Question) error {
+	return e.w.Write(spreadsheetSafe(exportCells(row, questions)))
+}
+
+func (e *csvExportWriter) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func (e *csvExportWriter) Close() error { return e.Flush() }
+
+type xlsxExportWriter struct{ w *utils.XLSXWriter }
+
+func (e *xlsxExportWriter) WriteHeader(questions []models.Question) error {
+	return e.w.WriteRow(spreadsheetSafe(exportHeader(questions)))
+}
+
+func (e *xlsxExportWriter) WriteRow(row exportRow, questions []models.Question) error {
+	return e.w.WriteRow(spreadsheetSafe(exportCells(row, questions)))
+}
+
+func (e *xlsxExportWriter) Flush() error { return e.w.Flush() }
+
+func (e *xlsxExportWriter) Close() error { return e.w.Close() }
+
+type ndjsonExportWriter struct{ enc *json.Encoder }
+
+// WriteHeader is a no-op: every NDJSON line is self-describing
+func (e *ndjsonExportWriter) WriteHeader(questions []models.Question) error { return nil }
+
+func (e *ndjsonExportWriter) WriteRow(row exportRow, questions []models.Question) error {
+	return e.enc.Encode(row)
+}
+
+func (e *ndjsonExportWriter) Flush() error { return nil }
+
+func (e *ndjsonExportWriter) Close() error { return nil }
+
+// ExportApplications handles GET /admin/export/applications - streams applications with answers as CSV, XLSX or NDJSON
+func ExportApplications(c *gin.Context) {
+	format := c.DefaultQuery("format", "csv")
+	department := c.Query("department")
+	status := c.Query("status")
+
+	if format != "csv" && format != "xlsx" && format != "ndjson" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format. Must be one of: csv, xlsx, ndjson"})
+		return
+	}
+	if department != "" && !requireDepartment(c, department) {
+		return
+	}
+	if status != "" && !slices.Contains(models.ApplicationStatuses, status) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status", "statuses": models.ApplicationStatuses})
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	questions, err := fetchExportQuestions(ctx, department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch questions",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	tx, err := services.DB.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to start export",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(context.Background())
+
+	// DECLARE cannot take bind parameters, so arguments are interpolated client-side by pgx
+	_, err = tx.Exec(ctx, queries.DeclareExportApplicationsCursorQuery, pgx.QueryExecModeSimpleProtocol, department, status)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to open export cursor",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	filename := fmt.Sprintf("applications-%s.%s", time.Now().UTC().Format("20060102-150405"), format)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Cache-Control", "no-store")
+
+	var writer exportWriter
+	switch format {
+	case "csv":
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		writer = &csvExportWriter{w: csv.NewWriter(c.Writer)}
+	case "xlsx":
+		c.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		xw, err := utils.NewXLSXWriter(c.Writer, "Applications")
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to start XLSX export",
+				"details": err.Error(),
+			})
+			return
+		}
+		writer = &xlsxExportWriter{w: xw}
+	case "ndjson":
+		c.Header("Content-Type", "application/x-ndjson")
+		writer = &ndjsonExportWriter{enc: json.NewEncoder(c.Writer)}
+	}
+	c.Status(http.StatusOK)
+
+	// From here on the response has started, so failures can only be logged by aborting the stream
+	if err := streamExport(ctx, tx, writer, questions, c.Writer); err != nil {
+		c.Error(err)
+		c.Abort()
+		return
+	}
+	writer.Close()
+}
+
+// streamExport drains the export cursor in batches and writes each row as it arrives
+func streamExport(ctx context.Context, tx pgx.Tx, writer exportWriter, questions []models.Question, w gin.ResponseWriter) error {
+	if err := writer.WriteHeader(questions); err != nil {
+		return err
+	}
+
+	for {
+		rows, err := tx.Query(ctx, queries.FetchExportApplicationsQuery)
+		if err != nil {
+			return fmt.Errorf("failed to fetch export batch: %w", err)
+		}
+
+		fetched := 0
+		for rows.Next() {
+			var row exportRow
+			var answers map[string]string
+			err := rows.Scan(
+				&row.ApplicationID, &row.Department, &row.Status, &row.SubmittedAt, &row.CreatedAt, &row.UpdatedAt,
+				&row.User.ID, &row.User.FullName, &row.User.Email, &row.User.RegNum, &row.User.PhoneNumber,
+				&row.User.Verified, &row.User.ChickenedOut, &row.User.Role, &row.User.CreatedAt, &row.User.UpdatedAt,
+				&answers,
+			)
+			if err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan export row: %w", err)
+			}
+			row.Answers = answers
+
+			if err := writer.WriteRow(row, questions); err != nil {
+				rows.Close()
+				return err
+			}
+			fetched++
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to read export batch: %w", err)
+		}
+
+		if fetched == 0 {
+			return nil
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		w.Flush()
+	}
+}
+
+// fetchExportQuestions returns the questions that become pivoted columns
+func fetchExportQuestions(ctx context.Context, department string) ([]models.Question, error) {
+	rows, err := services.DB.Query(ctx, queries.GetExportQuestionsQuery, department)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var questions []models.Question
+	for rows.Next() {
+		var q models.Question
+		if err := rows.Scan(&q.ID, &q.Department, &q.Domain, &q.Body, &q.CreatedAt); err != nil {
+			return nil, err
+		}
+		questions = append(questions, q)
+	}
+	return questions, rows.Err()
+}
@@ -0,0 +1,329 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// GetApplicationNotes handles GET /applications/:id/notes - lists the notes the current evaluator may read (evaluator+)
+func GetApplicationNotes(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+	viewerID, isAdmin, ok := currentStaff(c)
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetApplicationNotesQuery, applicationID, viewerID, isAdmin)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch notes", "details": err.Error()})
+		return
+	}
+	notes, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ApplicationNote, error) {
+		return scanApplicationNote(row)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan notes", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"notes":          notes,
+		"count":          len(notes),
+		"application_id": applicationID,
+	})
+}
+
+// CreateApplicationNote handles POST /applications/:id/notes - writes a note, private unless stated otherwise (evaluator+)
+func CreateApplicationNote(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+
+	var req models.ApplicationNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	if req.Visibility == "" {
+		req.Visibility = models.NoteVisibilityPrivate
+	}
+
+	authorID, _, ok := currentStaff(c)
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	note, err := scanApplicationNote(services.DB.QueryRow(ctx, queries.CreateApplicationNoteQuery,
+		uuid.New(), applicationID, authorID, req.Body, req.Visibility, time.Now(),
+	))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create note", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Note created successfully",
+		"note":    note,
+	})
+}
+
+// UpdateApplicationNote handles PUT /applications/:id/notes/:note_id - edits a note; only its author may (evaluator+)
+func UpdateApplicationNote(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+	noteID, err := uuid.Parse(c.Param("note_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid note ID"})
+		return
+	}
+
+	var req models.ApplicationNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	if req.Visibility == "" {
+		req.Visibility = models.NoteVisibilityPrivate
+	}
+
+	authorID, _, ok := currentStaff(c)
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	note, err := scanApplicationNote(services.DB.QueryRow(ctx, queries.UpdateApplicationNoteQuery,
+		noteID, applicationID, req.Body, req.Visibility, authorID, time.Now(),
+	))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Note not found or not written by you"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update note", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Note updated successfully",
+		"note":    note,
+	})
+}
+
+// DeleteApplicationNote handles DELETE /applications/:id/notes/:note_id - removes a note; its author or an admin may (evaluator+)
+func DeleteApplicationNote(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+	noteID, err := uuid.Parse(c.Param("note_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid note ID"})
+		return
+	}
+
+	userID, isAdmin, ok := currentStaff(c)
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	var authorID uuid.UUID
+	err = services.DB.QueryRow(ctx, queries.GetApplicationNoteAuthorQuery, noteID, applicationID).Scan(&authorID)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Note not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch note", "details": err.Error()})
+		return
+	}
+	if authorID != userID && !isAdmin {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Only the author or an admin can delete this note"})
+		return
+	}
+
+	if _, err := services.DB.Exec(ctx, queries.DeleteApplicationNoteQuery, noteID, applicationID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete note", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Note deleted successfully"})
+}
+
+// GetApplicationTags handles GET /applications/:id/tags - lists an application's tags (evaluator+)
+func GetApplicationTags(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+
+	ctx := context.Background()
+	tags, err := fetchApplicationTags(ctx, applicationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tags", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"tags":           tags,
+		"application_id": applicationID,
+	})
+}
+
+// AddApplicationTag handles POST /applications/:id/tags - tags an application (evaluator+).
+// Tags are lower-cased with spaces turned into dashes; adding an existing tag is a no-op.
+func AddApplicationTag(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+
+	var req models.AddApplicationTagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	tag, err := models.NormaliseTag(req.Tag)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag", "details": err.Error()})
+		return
+	}
+
+	userID, _, ok := currentStaff(c)
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	if _, err := services.DB.Exec(ctx, queries.AddApplicationTagQuery, applicationID, tag, userID, time.Now()); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to add tag", "details": err.Error()})
+		return
+	}
+
+	tags, err := fetchApplicationTags(ctx, applicationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tags", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":        "Tag added successfully",
+		"tags":           tags,
+		"application_id": applicationID,
+	})
+}
+
+// DeleteApplicationTag handles DELETE /applications/:id/tags/:tag - removes a tag from an application (evaluator+)
+func DeleteApplicationTag(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+	tag, err := models.NormaliseTag(c.Param("tag"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tag", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.DeleteApplicationTagQuery, applicationID, tag)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove tag", "details": err.Error()})
+		return
+	}
+	if result.RowsAffected() == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Tag not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tag removed successfully"})
+}
+
+// currentStaff returns the current user's ID and whether they are an admin, writing the error response when unauthenticated
+func currentStaff(c *gin.Context) (uuid.UUID, bool, bool) {
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return uuid.Nil, false, false
+	}
+	role, _ := c.Get("userRole")
+	isAdmin := role == models.RoleAdmin || role == models.RoleSuperAdmin
+	return userIDInterface.(uuid.UUID), isAdmin, true
+}
+
+// fetchApplicationTags lists an application's tags
+func fetchApplicationTags(ctx context.Context, applicationID uuid.UUID) ([]models.ApplicationTag, error) {
+	rows, err := services.DB.Query(ctx, queries.GetApplicationTagsQuery, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ApplicationTag, error) {
+		var t models.ApplicationTag
+		err := row.Scan(&t.ApplicationID, &t.Tag, &t.CreatedBy, &t.CreatedAt)
+		return t, err
+	})
+}
+
+// scanApplicationNote scans a row selected with the note columns
+func scanApplicationNote(row pgx.Row) (models.ApplicationNote, error) {
+	var n models.ApplicationNote
+	err := row.Scan(&n.ID, &n.ApplicationID, &n.AuthorID, &n.AuthorName, &n.Body, &n.Visibility, &n.CreatedAt, &n.UpdatedAt)
+	return n, err
+}
@@ -0,0 +1,147 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetMyNotifications handles GET /notifications - fetches the current user's notifications
+func GetMyNotifications(c *gin.Context) {
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	unreadOnly := c.Query("unread") == "true"
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 || limit > 200 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Limit must be between 1 and 200"})
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetUserNotificationsQuery, userID, unreadOnly, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch notifications",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	var notifications []models.Notification
+	for rows.Next() {
+		var notification models.Notification
+		err := rows.Scan(
+			&notification.ID, &notification.UserID, &notification.Type, &notification.Title,
+			&notification.Body, &notification.Data, &notification.ReadAt, &notification.CreatedAt,
+		)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan notification data",
+				"details": err.Error(),
+			})
+			return
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading notifications",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	var unreadCount int
+	if err := services.DB.QueryRow(ctx, queries.CountUnreadNotificationsQuery, userID).Scan(&unreadCount); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to count unread notifications",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Notifications fetched successfully",
+		"notifications": notifications,
+		"count":         len(notifications),
+		"unread_count":  unreadCount,
+	})
+}
+
+// MarkNotificationRead handles POST /notifications/:id/read - marks a notification as read
+func MarkNotificationRead(c *gin.Context) {
+	notificationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid notification ID"})
+		return
+	}
+
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	var notification models.Notification
+	err = services.DB.QueryRow(ctx, queries.MarkNotificationReadQuery, notificationID, userID).Scan(
+		&notification.ID, &notification.UserID, &notification.Type, &notification.Title,
+		&notification.Body, &notification.Data, &notification.ReadAt, &notification.CreatedAt,
+	)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Notification not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to mark notification as read",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":      "Notification marked as read",
+		"notification": notification,
+	})
+}
+
+// MarkAllNotificationsRead handles POST /notifications/read-all - marks all of the user's notifications as read
+func MarkAllNotificationsRead(c *gin.Context) {
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.MarkAllNotificationsReadQuery, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to mark notifications as read",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "All notifications marked as read",
+		"updated": result.RowsAffected(),
+	})
+}
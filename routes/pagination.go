@@ -0,0 +1,200 @@
+package routes
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Page sizes for list endpoints
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+)
+
+// pageRequest holds the parsed ?limit, ?sort, ?order and ?cursor parameters of a list endpoint
+type pageRequest struct {
+	SortName string
+	Sort     queries.SortField
+	Desc     bool
+	After    *queries.Keyset
+	Limit    int
+}
+
+// parsePageRequest reads the pagination parameters, allowing only the given sort fields
+func parsePageRequest(c *gin.Context, sortFields map[string]queries.SortField, defaultSort string, defaultDesc bool) (pageRequest, error) {
+	page := pageRequest{Limit: defaultPageLimit, SortName: c.DefaultQuery("sort", defaultSort), Desc: defaultDesc}
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxPageLimit {
+			return page, fmt.Errorf("limit must be between 1 and %d", maxPageLimit)
+		}
+		page.Limit = limit
+	}
+
+	sort, ok := sortFields[page.SortName]
+	if !ok {
+		allowed := make([]string, 0, len(sortFields))
+		for name := range sortFields {
+			allowed = append(allowed, name)
+		}
+		slices.Sort(allowed)
+		return page, fmt.Errorf("sort must be one of: %s", strings.Join(allowed, ", "))
+	}
+	page.Sort = sort
+
+	switch c.Query("order") {
+	case "":
+	case "asc":
+		page.Desc = false
+	case "desc":
+		page.Desc = true
+	default:
+		return page, errors.New("order must be asc or desc")
+	}
+
+	if raw := c.Query("cursor"); raw != "" {
+		cursor, err := utils.DecodeCursor(raw)
+		if _, parseErr := uuid.Parse(cursor.ID); err != nil || parseErr != nil || !validCursorValue(page.Sort, cursor.Value) {
+			return page, utils.ErrInvalidCursor
+		}
+		if cursor.Sort != page.SortName || cursor.Desc != page.Desc {
+			return page, errors.New("cursor does not match the requested sort order")
+		}
+		page.After = &queries.Keyset{Value: cursor.Value, ID: cursor.ID}
+	}
+
+	return page, nil
+}
+
+// cursorTimeLayouts are the forms Postgres writes a timestamptz sort key in with the default ISO DateStyle;
+// fractional seconds are accepted after the seconds by time.Parse
+var cursorTimeLayouts = []string{"2006-01-02 15:04:05-07", "2006-01-02 15:04:05-07:00", "2006-01-02 15:04:05-07:00:00"}
+
+// validCursorValue reports whether a cursor's sort key can be cast back to the sort field's SQL type,
+// so a tampered cursor is rejected instead of failing in the database
+func validCursorValue(sort queries.SortField, value string) bool {
+	switch sort.Type {
+	case "integer":
+		_, err := strconv.ParseInt(value, 10, 32)
+		return err == nil
+	case "timestamptz":
+		for _, layout := range cursorTimeLayouts {
+			if _, err := time.Parse(layout, value); err == nil {
+				return true
+			}
+		}
+		return false
+	default:
+		return utf8.ValidString(value) && !strings.ContainsRune(value, 0)
+	}
+}
+
+// paginate drops the lookahead row fetched by ListQuery.Build and returns the cursor for the next page, if there is one.
+// keys holds the sort key selected alongside each item.
+func paginate[T any](page pageRequest, items []T, keys []string, id func(T) uuid.UUID) ([]T, *string) {
+	if len(items) <= page.Limit {
+		return items, nil
+	}
+	items = items[:page.Limit]
+	next := utils.EncodeCursor(utils.PageCursor{
+		Sort:  page.SortName,
+		Desc:  page.Desc,
+		Value: keys[page.Limit-1],
+		ID:    id(items[page.Limit-1]).String(),
+	})
+	return items, &next
+}
+
+// filterBool adds "column = value" when the boolean query parameter is present
+func filterBool(c *gin.Context, q *queries.ListQuery, param, column string) error {
+	raw := c.Query(param)
+	if raw == "" {
+		return nil
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return fmt.Errorf("%s must be true or false", param)
+	}
+	q.Where(column+" = ?", value)
+	return nil
+}
+
+// filterDepartment adds "column = department" when ?department is present
+func filterDepartment(c *gin.Context, q *queries.ListQuery, column string) error {
+	department := c.Query("department")
+	if department == "" {
+		return nil
+	}
+	exists, err := departmentExists(c.Request.Context(), department)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("invalid department: %s", department)
+	}
+	q.Where(column+"::text = ?", department)
+	return nil
+}
+
+// filterCreatedRange adds ?created_from / ?created_to bounds on column.
+// Both accept RFC 3339 timestamps or plain dates; a plain created_to date includes the whole day.
+func filterCreatedRange(c *gin.Context, q *queries.ListQuery, column string) error {
+	if raw := c.Query("created_from"); raw != "" {
+		from, _, err := parseFilterTime(raw)
+		if err != nil {
+			return fmt.Errorf("created_from: %w", err)
+		}
+		q.Where(column+" >= ?", from)
+	}
+	if raw := c.Query("created_to"); raw != "" {
+		to, dateOnly, err := parseFilterTime(raw)
+		if err != nil {
+			return fmt.Errorf("created_to: %w", err)
+		}
+		if dateOnly {
+			q.Where(column+" < ?", to.AddDate(0, 0, 1))
+		} else {
+			q.Where(column+" <= ?", to)
+		}
+	}
+	return nil
+}
+
+// filterSearch adds a case-insensitive substring match of ?search across the given columns
+func filterSearch(c *gin.Context, q *queries.ListQuery, columns ...string) {
+	search := strings.TrimSpace(c.Query("search"))
+	if search == "" {
+		return
+	}
+	pattern := queries.LikePattern(search)
+	matches := make([]string, len(columns))
+	args := make([]any, len(columns))
+	for i, column := range columns {
+		matches[i] = column + " ILIKE ?"
+		args[i] = pattern
+	}
+	q.Where(strings.Join(matches, " OR "), args...)
+}
+
+// parseFilterTime parses an RFC 3339 timestamp or a YYYY-MM-DD date
+func parseFilterTime(raw string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, false, nil
+	}
+	t, err := time.Parse(time.DateOnly, raw)
+	if err != nil {
+		return t, false, errors.New("must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+	}
+	return t, true, nil
+}
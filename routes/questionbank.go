@@ -0,0 +1,88 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+)
+
+// maxQuestionBankSize caps the size of an uploaded question bank
+const maxQuestionBankSize = 1 << 20
+
+// questionBankContentTypes maps export formats to their content types
+var questionBankContentTypes = map[string]string{
+	"yaml": "application/yaml",
+	"json": "application/json",
+}
+
+// ImportQuestionBank handles POST /admin/questions/import?dry_run=&prune=
+// The request body is the raw YAML or JSON bank. Every change is applied in one transaction;
+// with dry_run=true nothing is written and the planned changes are returned instead.
+func ImportQuestionBank(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxQuestionBankSize+1))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to read question bank", "details": err.Error()})
+		return
+	}
+	if len(body) > maxQuestionBankSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Question bank is too large"})
+		return
+	}
+
+	ctx := context.Background()
+	result, err := services.ImportQuestionBank(ctx, body, c.Query("dry_run") == "true", c.Query("prune") == "true")
+	if err != nil {
+		var bankErr *services.QuestionBankError
+		if errors.As(err, &bankErr) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "Invalid question bank", "issues": bankErr.Issues})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to import question bank", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ExportQuestionBank handles GET /admin/questions/export?format=yaml|json&department=
+func ExportQuestionBank(c *gin.Context) {
+	format := c.DefaultQuery("format", "yaml")
+	contentType, ok := questionBankContentTypes[format]
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid format. Must be one of: yaml, json"})
+		return
+	}
+
+	department := c.Query("department")
+	if department != "" && !requireDepartment(c, department) {
+		return
+	}
+
+	ctx := context.Background()
+	bank, err := services.ExportQuestionBank(ctx, department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export question bank", "details": err.Error()})
+		return
+	}
+
+	data, err := services.EncodeQuestionBank(bank, format)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to encode question bank", "details": err.Error()})
+		return
+	}
+
+	scope := "all"
+	if department != "" {
+		scope = department
+	}
+	filename := fmt.Sprintf("questions-%s-%s.%s", scope, time.Now().UTC().Format("20060102"), format)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, contentType, data)
+}
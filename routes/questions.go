@@ -2,7 +2,10 @@ package routes
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/ComputerSocietyVITC/recruitment-backend/models"
@@ -10,8 +13,13 @@ import (
 	"github.com/ComputerSocietyVITC/recruitment-backend/services"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
+// GetQuestions handles GET /questions?dept=&domains= - lists a department's questions.
+// With a comma-separated list of sub-domains, questions for other sub-domains are left out.
+// Only rounds the caller's application has been admitted to are listed, and conditional questions
+// only once the caller's answers satisfy their conditions.
 func GetQuestions(c *gin.Context) {
 	dept := c.Query("dept")
 	if dept == "" {
@@ -19,8 +27,19 @@ func GetQuestions(c *gin.Context) {
 		return
 	}
 
+	domains := []string{}
+	if raw := c.Query("domains"); raw != "" {
+		domains = strings.Split(raw, ",")
+	}
+
+	// Applicants only see the rounds their application has been admitted to
+	userID := uuid.Nil
+	if id, exists := c.Get("userID"); exists {
+		userID = id.(uuid.UUID)
+	}
+
 	ctx := context.Background()
-	rows, err := services.DB.Query(ctx, queries.GetQuestionsByDepartmentQuery, dept)
+	rows, err := services.DB.Query(ctx, queries.GetQuestionsByDepartmentQuery, dept, domains, userID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch questions", "details": err.Error()})
 		return
@@ -30,7 +49,7 @@ func GetQuestions(c *gin.Context) {
 	var questions []models.Question
 	for rows.Next() {
 		var q models.Question
-		err := rows.Scan(&q.ID, &q.Department, &q.Body, &q.CreatedAt)
+		err := rows.Scan(&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan question", "details": err.Error()})
 			return
@@ -44,29 +63,105 @@ func GetQuestions(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, questions)
+	visible, err := visibleQuestions(ctx, userID, dept, questions)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to evaluate question conditions", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, visible)
 }
 
-// GetAllQuestions returns all questions from all departments
+// visibleQuestions drops the questions whose conditions are not met by the caller's answers on their application to the department.
+// Callers without an application have no answers, so they only see unconditional questions.
+func visibleQuestions(ctx context.Context, userID uuid.UUID, dept string, questions []models.Question) ([]models.Question, error) {
+	if err := attachQuestionConditions(ctx, questions); err != nil {
+		return nil, err
+	}
+
+	answers := map[uuid.UUID]string{}
+	if userID != uuid.Nil {
+		rows, err := services.DB.Query(ctx, queries.GetUserDepartmentAnswersQuery, userID, dept)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var questionID uuid.UUID
+			var body string
+			if err := rows.Scan(&questionID, &body); err != nil {
+				return nil, err
+			}
+			answers[questionID] = body
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	shown := models.VisibleQuestions(questions, answers)
+	visible := []models.Question{}
+	for _, q := range questions {
+		if shown[q.ID] {
+			visible = append(visible, q)
+		}
+	}
+	return visible, nil
+}
+
+// GetAllQuestions returns questions from all departments with filtering, sorting and cursor pagination
 func GetAllQuestions(c *gin.Context) {
+	page, err := parsePageRequest(c, queries.QuestionSortFields, "created_at", false)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pagination parameters", "details": err.Error()})
+		return
+	}
+
+	list := queries.NewListQuery(queries.ListQuestionsColumns, queries.ListQuestionsFrom, "id")
+	if err := filterDepartment(c, list, "department"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filter parameters", "details": err.Error()})
+		return
+	}
+	if domain := c.Query("domain"); domain != "" {
+		list.Where("domain = ?", domain)
+	}
+	if raw := c.Query("round"); raw != "" {
+		roundID, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round filter"})
+			return
+		}
+		list.Where("round_id = ?", roundID)
+	}
+	if err := filterBool(c, list, "archived", "(archived_at IS NOT NULL)"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filter parameters", "details": err.Error()})
+		return
+	}
+	filterSearch(c, list, "body")
+
+	sql, args := list.Build(page.Sort, page.Desc, page.After, page.Limit)
+
 	ctx := context.Background()
-	rows, err := services.DB.Query(ctx, queries.GetAllQuestionsQuery)
+	rows, err := services.DB.Query(ctx, sql, args...)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch questions", "details": err.Error()})
 		return
 	}
 	defer rows.Close()
 
-	var questions []models.Question
+	questions := []models.Question{}
+	var keys []string
 	for rows.Next() {
 		var q models.Question
-		err := rows.Scan(&q.ID, &q.Department, &q.Body, &q.CreatedAt)
+		var key string
+		err := rows.Scan(&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt, &key)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan question", "details": err.Error()})
 			return
 		}
 
 		questions = append(questions, q)
+		keys = append(keys, key)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -74,10 +169,14 @@ func GetAllQuestions(c *gin.Context) {
 		return
 	}
 
+	questions, nextCursor := paginate(page, questions, keys, func(q models.Question) uuid.UUID { return q.ID })
+
 	c.JSON(http.StatusOK, gin.H{
-		"message":   "Questions fetched successfully",
-		"questions": questions,
-		"count":     len(questions),
+		"message":     "Questions fetched successfully",
+		"questions":   questions,
+		"count":       len(questions),
+		"limit":       page.Limit,
+		"next_cursor": nextCursor,
 	})
 }
 
@@ -94,7 +193,7 @@ func GetQuestionByID(c *gin.Context) {
 	row := services.DB.QueryRow(ctx, queries.GetQuestionByIDQuery, questionID)
 
 	var q models.Question
-	err = row.Scan(&q.ID, &q.Department, &q.Body, &q.CreatedAt)
+	err = row.Scan(&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt)
 	if err != nil {
 		if err.Error() == "no rows in result set" {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Question not found"})
@@ -104,7 +203,13 @@ func GetQuestionByID(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, q)
+	questions := []models.Question{q}
+	if err := attachQuestionConditions(ctx, questions); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch question conditions", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, questions[0])
 }
 
 // CreateQuestion creates a new question
@@ -115,15 +220,26 @@ func CreateQuestion(c *gin.Context) {
 		return
 	}
 
-	// Validate department
-	validDepartments := map[string]bool{
-		"technical":    true,
-		"marketing":    true,
-		"management":   true,
-		"social_media": true,
+	// Validate department (inactive departments may still get questions ahead of opening)
+	if !requireDepartment(c, req.Department) {
+		return
+	}
+
+	domain, ok := requireQuestionDomain(c, req.Department, req.Domain)
+	if !ok {
+		return
+	}
+	if req.Type == "" {
+		req.Type = models.QuestionTypeLongText
+	}
+	if err := models.ValidateQuestionShape(req.Type, req.Options); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question", "details": err.Error()})
+		return
+	}
+	if !requireQuestionConditions(c, uuid.Nil, req.Department, req.Conditions) {
+		return
 	}
-	if !validDepartments[req.Department] {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid department. Must be one of: technical, marketing, management, social_media"})
+	if req.RoundID != nil && !requireRound(c, req.Department, *req.RoundID) {
 		return
 	}
 
@@ -131,19 +247,35 @@ func CreateQuestion(c *gin.Context) {
 	createdAt := time.Now()
 
 	ctx := context.Background()
-	row := services.DB.QueryRow(ctx, queries.CreateQuestionQuery, questionID, req.Department, req.Body, createdAt)
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start transaction", "details": err.Error()})
+		return
+	}
+	defer tx.Rollback(ctx)
 
 	var q models.Question
-	err := row.Scan(&q.ID, &q.Department, &q.Body, &q.CreatedAt)
+	err = tx.QueryRow(ctx, queries.CreateQuestionQuery, questionID, req.Department, domain, req.Type, req.Body, req.Options, req.Required, createdAt, req.RoundID).Scan(
+		&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt,
+	)
+	if err == nil {
+		err = replaceQuestionConditions(ctx, tx, q.ID, req.Conditions, createdAt)
+	}
+	if err == nil {
+		err = tx.Commit(ctx)
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create question", "details": err.Error()})
 		return
 	}
+	q.Conditions = req.Conditions
 
 	c.JSON(http.StatusCreated, q)
 }
 
-// DeleteQuestion deletes a question by its ID
+// DeleteQuestion deletes a question by its ID. Questions that have been answered are archived instead,
+// so applicants' answers are kept. Questions other live questions depend on are left alone: deleting one
+// would drop their conditions and archiving it would hide them for good.
 func DeleteQuestion(c *gin.Context) {
 	idParam := c.Param("id")
 	questionID, err := uuid.Parse(idParam)
@@ -153,6 +285,24 @@ func DeleteQuestion(c *gin.Context) {
 	}
 
 	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetQuestionDependentsQuery, questionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch dependent questions", "details": err.Error()})
+		return
+	}
+	dependents, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan dependent questions", "details": err.Error()})
+		return
+	}
+	if len(dependents) > 0 {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":      "Other questions are shown depending on this question's answers; change their conditions first",
+			"dependents": dependents,
+		})
+		return
+	}
+
 	result, err := services.DB.Exec(ctx, queries.DeleteQuestionByIDQuery, questionID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete question", "details": err.Error()})
@@ -160,10 +310,413 @@ func DeleteQuestion(c *gin.Context) {
 	}
 
 	rowsAffected := result.RowsAffected()
-	if rowsAffected == 0 {
+	if rowsAffected > 0 {
+		c.JSON(http.StatusOK, gin.H{"message": "Question deleted successfully", "archived": false})
+		return
+	}
+
+	var q models.Question
+	err = services.DB.QueryRow(ctx, queries.ArchiveQuestionQuery, questionID, time.Now()).Scan(
+		&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Question not found or already archived"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to archive question", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Question has answers and was archived instead of deleted",
+		"archived": true,
+		"question": q,
+	})
+}
+
+// UpdateQuestion handles PUT /questions/:id - edits a live question's wording or sub-domain.
+// Changing the wording creates a new version; existing answers stay linked to the version they were written against.
+func UpdateQuestion(c *gin.Context) {
+	questionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question ID format"})
+		return
+	}
+
+	var req models.UpdateQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+	var q models.Question
+	err = services.DB.QueryRow(ctx, queries.GetQuestionByIDQuery, questionID).Scan(
+		&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Question not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch question", "details": err.Error()})
+		return
+	}
+	if q.ArchivedAt != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "Archived questions cannot be edited; restore it first"})
+		return
+	}
+
+	domain, ok := requireQuestionDomain(c, q.Department, req.Domain)
+	if !ok {
+		return
+	}
+	if req.Type == "" {
+		req.Type = q.Type
+	}
+	if req.Options == nil && req.Type == q.Type {
+		req.Options = q.Options
+	}
+	if err := models.ValidateQuestionShape(req.Type, req.Options); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question", "details": err.Error()})
+		return
+	}
+	if req.Type != q.Type || !slices.Equal(req.Options, q.Options) {
+		// Existing answers were validated against the current type and options
+		var answered bool
+		if err := services.DB.QueryRow(ctx, queries.QuestionHasAnswersQuery, questionID).Scan(&answered); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check question answers", "details": err.Error()})
+			return
+		}
+		if answered {
+			c.JSON(http.StatusConflict, gin.H{"error": "The type and options of an answered question cannot be changed"})
+			return
+		}
+	}
+	if !requireQuestionConditions(c, questionID, q.Department, req.Conditions) {
+		return
+	}
+	if req.RoundID != nil && !requireRound(c, q.Department, *req.RoundID) {
+		return
+	}
+
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start transaction", "details": err.Error()})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	err = tx.QueryRow(ctx, queries.UpdateQuestionQuery, questionID, req.Body, domain, req.Type, req.Options, req.Required, now, req.RoundID).Scan(
+		&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt,
+	)
+	if err == nil {
+		err = replaceQuestionConditions(ctx, tx, questionID, req.Conditions, now)
+	}
+	if err == nil {
+		err = tx.Commit(ctx)
+	}
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusConflict, gin.H{"error": "Question was archived while being edited"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update question", "details": err.Error()})
+		return
+	}
+	q.Conditions = req.Conditions
+
+	c.JSON(http.StatusOK, q)
+}
+
+// RestoreQuestion handles POST /questions/:id/restore - brings an archived question back at the end of its department
+func RestoreQuestion(c *gin.Context) {
+	questionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	var q models.Question
+	err = services.DB.QueryRow(ctx, queries.RestoreQuestionQuery, questionID, time.Now()).Scan(
+		&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Archived question not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to restore question", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, q)
+}
+
+// ReorderQuestions handles PUT /questions/order - sets the display order of a department's live questions.
+// The request must list every live question of the department exactly once.
+func ReorderQuestions(c *gin.Context) {
+	var req models.ReorderQuestionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	if !requireDepartment(c, req.Department) {
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetLiveQuestionIDsByDepartmentQuery, req.Department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch questions", "details": err.Error()})
+		return
+	}
+	live := map[uuid.UUID]bool{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan question", "details": err.Error()})
+			return
+		}
+		live[id] = false
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error occurred while reading questions", "details": err.Error()})
+		return
+	}
+
+	for _, id := range req.QuestionIDs {
+		seen, ok := live[id]
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Question is not a live question of this department", "question_id": id})
+			return
+		}
+		if seen {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Question listed more than once", "question_id": id})
+			return
+		}
+		live[id] = true
+	}
+	if len(req.QuestionIDs) != len(live) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":    "Every live question of the department must be listed",
+			"expected": len(live),
+			"received": len(req.QuestionIDs),
+		})
+		return
+	}
+
+	if _, err := services.DB.Exec(ctx, queries.ReorderQuestionsQuery, req.Department, req.QuestionIDs, time.Now()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reorder questions", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Questions reordered successfully", "count": len(req.QuestionIDs)})
+}
+
+// GetQuestionVersions handles GET /questions/:id/versions - lists every wording a question has had (evaluator+)
+func GetQuestionVersions(c *gin.Context) {
+	questionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid question ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetQuestionVersionsQuery, questionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch question versions", "details": err.Error()})
+		return
+	}
+	defer rows.Close()
+
+	versions := []models.QuestionVersion{}
+	for rows.Next() {
+		var v models.QuestionVersion
+		if err := rows.Scan(&v.QuestionID, &v.Version, &v.Body, &v.CreatedAt); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan question version", "details": err.Error()})
+			return
+		}
+		versions = append(versions, v)
+	}
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error occurred while reading question versions", "details": err.Error()})
+		return
+	}
+	if len(versions) == 0 {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Question not found"})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"message": "Question deleted successfully"})
+	c.JSON(http.StatusOK, gin.H{"versions": versions, "count": len(versions)})
+}
+
+// requireQuestionDomain validates an optional sub-domain for a question, responding with an error when invalid.
+// It returns nil for department-wide questions.
+func requireQuestionDomain(c *gin.Context, department, domain string) (*string, bool) {
+	if domain == "" {
+		return nil, true
+	}
+	if _, err := validateDepartmentDomains(c.Request.Context(), department, []string{domain}); err != nil {
+		if errors.Is(err, errInvalidDomains) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid domain for this department", "domain": domain})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate domain", "details": err.Error()})
+		return nil, false
+	}
+	return &domain, true
+}
+
+// requireQuestionConditions validates visibility conditions for a question of a department, writing the error response when
+// they are invalid. Conditions must depend on other live questions of the same department and may not form a cycle.
+// questionID is uuid.Nil for a question that does not exist yet.
+func requireQuestionConditions(c *gin.Context, questionID uuid.UUID, department string, conditions []models.QuestionCondition) bool {
+	if len(conditions) == 0 {
+		return true
+	}
+
+	ctx := c.Request.Context()
+	rows, err := services.DB.Query(ctx, queries.GetLiveQuestionsByDepartmentsQuery, []string{department})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate conditions", "details": err.Error()})
+		return false
+	}
+	live := map[uuid.UUID]models.Question{}
+	for rows.Next() {
+		var q models.Question
+		if err := rows.Scan(&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required, &q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt); err != nil {
+			rows.Close()
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate conditions", "details": err.Error()})
+			return false
+		}
+		live[q.ID] = q
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate conditions", "details": err.Error()})
+		return false
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, condition := range conditions {
+		dependency, ok := live[condition.DependsOn]
+		switch {
+		case condition.DependsOn == questionID:
+			err = errors.New("a question cannot depend on itself")
+		case seen[condition.DependsOn]:
+			err = errors.New("only one condition per question is allowed")
+		case !ok:
+			err = errors.New("conditions must depend on a live question of the same department")
+		default:
+			err = models.ValidateCondition(condition, dependency)
+		}
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid condition", "depends_on": condition.DependsOn, "details": err.Error()})
+			return false
+		}
+		seen[condition.DependsOn] = true
+	}
+
+	// A new question cannot be depended on yet, so only edits can introduce a cycle
+	if questionID == uuid.Nil {
+		return true
+	}
+
+	rows, err = services.DB.Query(ctx, queries.GetDepartmentQuestionConditionsQuery, department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate conditions", "details": err.Error()})
+		return false
+	}
+	dependsOn := map[uuid.UUID][]uuid.UUID{}
+	for rows.Next() {
+		var from, to uuid.UUID
+		if err := rows.Scan(&from, &to); err != nil {
+			rows.Close()
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate conditions", "details": err.Error()})
+			return false
+		}
+		if from != questionID {
+			dependsOn[from] = append(dependsOn[from], to)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate conditions", "details": err.Error()})
+		return false
+	}
+
+	// Walk the questions this one would depend on; reaching it again means a cycle
+	visited := map[uuid.UUID]bool{}
+	pending := []uuid.UUID{}
+	for _, condition := range conditions {
+		pending = append(pending, condition.DependsOn)
+	}
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if id == questionID {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid condition", "details": "conditions would form a cycle"})
+			return false
+		}
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		pending = append(pending, dependsOn[id]...)
+	}
+	return true
+}
+
+// replaceQuestionConditions swaps a question's visibility conditions for the given ones
+func replaceQuestionConditions(ctx context.Context, tx pgx.Tx, questionID uuid.UUID, conditions []models.QuestionCondition, now time.Time) error {
+	if _, err := tx.Exec(ctx, queries.DeleteQuestionConditionsQuery, questionID); err != nil {
+		return err
+	}
+	for _, condition := range conditions {
+		if _, err := tx.Exec(ctx, queries.InsertQuestionConditionQuery, questionID, condition.DependsOn, condition.Operator, condition.Values, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachQuestionConditions fills in the visibility conditions of each question
+func attachQuestionConditions(ctx context.Context, questions []models.Question) error {
+	if len(questions) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(questions))
+	for i, q := range questions {
+		ids[i] = q.ID
+	}
+	rows, err := services.DB.Query(ctx, queries.GetQuestionConditionsQuery, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	byQuestion := map[uuid.UUID][]models.QuestionCondition{}
+	for rows.Next() {
+		var questionID uuid.UUID
+		var condition models.QuestionCondition
+		if err := rows.Scan(&questionID, &condition.DependsOn, &condition.Operator, &condition.Values); err != nil {
+			return err
+		}
+		byQuestion[questionID] = append(byQuestion[questionID], condition)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range questions {
+		questions[i].Conditions = byQuestion[questions[i].ID]
+	}
+	return nil
 }
@@ -0,0 +1,432 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// errResultsPublished is returned when staged results have already been published
+var errResultsPublished = errors.New("results have already been published")
+
+// errFeedbackNotStaged is returned when feedback is given for an application without a staged result
+var errFeedbackNotStaged = errors.New("feedback was given for an application without a result in this department")
+
+// StageResults handles PUT /admin/departments/:slug/results - stages a department's results for publication (admin+).
+// The decided applications are snapshotted as results, selected applicants receive an offer, and nothing is shown
+// to applicants until publish_at. Re-staging before then refreshes the snapshot and keeps the feedback written so far.
+func StageResults(c *gin.Context) {
+	slug := c.Param("slug")
+
+	var req models.StageResultsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	if req.ResponseDeadline != nil && !req.ResponseDeadline.After(req.PublishAt) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Response deadline must be after the publication time"})
+		return
+	}
+	if !requireDepartment(c, slug) {
+		return
+	}
+
+	adminID := c.MustGet("userID").(uuid.UUID)
+
+	ctx := context.Background()
+	results, err := stageDepartmentResults(ctx, slug, req, adminID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errResultsPublished):
+			c.JSON(http.StatusConflict, gin.H{"error": "Results for this department have already been published"})
+		case errors.Is(err, errFeedbackNotStaged):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to stage results", "details": err.Error()})
+		}
+		return
+	}
+
+	respondDepartmentResults(c, ctx, results, "Results staged successfully")
+}
+
+// GetDepartmentResults handles GET /admin/departments/:slug/results - shows a department's staged results (admin+)
+func GetDepartmentResults(c *gin.Context) {
+	slug := c.Param("slug")
+
+	ctx := context.Background()
+	results, err := scanDepartmentResults(services.DB.QueryRow(ctx, queries.GetDepartmentResultsQuery, slug))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No results have been staged for this department"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch results", "details": err.Error()})
+		return
+	}
+
+	respondDepartmentResults(c, ctx, results, "Results fetched successfully")
+}
+
+// UnstageResults handles DELETE /admin/departments/:slug/results - withdraws staged results before publication (admin+)
+func UnstageResults(c *gin.Context) {
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.DeleteDepartmentResultsQuery, c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to withdraw results", "details": err.Error()})
+		return
+	}
+	if result.RowsAffected() == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No unpublished results are staged for this department"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Results withdrawn successfully"})
+}
+
+// SetResultFeedback handles PUT /admin/applications/:id/result/feedback - sets the feedback shown with a result (admin+)
+func SetResultFeedback(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	var req models.SetResultFeedbackRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+	result, err := scanApplicationResult(services.DB.QueryRow(ctx, queries.SetResultFeedbackQuery, applicationID, req.Feedback, time.Now()))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No result has been staged for this application"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to set feedback", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Feedback saved successfully",
+		"result":  result,
+	})
+}
+
+// GetMyResults handles GET /applications/me/results - lists the outcome of each of the user's applications.
+// Outcomes, feedback and offers only appear once the department's results have been published.
+func GetMyResults(c *gin.Context) {
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetUserResultsQuery, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch your results", "details": err.Error()})
+		return
+	}
+	now := time.Now()
+	results, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.MyResult, error) {
+		var my models.MyResult
+		var hasResult bool
+		var outcome, feedback *string
+		var createdAt, updatedAt *time.Time
+		r := models.ApplicationResult{}
+		err := row.Scan(
+			&my.ApplicationID, &my.Department, &my.Published, &hasResult,
+			&outcome, &feedback, &r.OfferStatus, &r.ResponseDeadline, &r.RespondedAt, &r.WaitlistPosition, &r.PromotedAt,
+			&createdAt, &updatedAt,
+		)
+		if err != nil || !hasResult {
+			return my, err
+		}
+		r.ApplicationID, r.Department = my.ApplicationID, my.Department
+		r.Outcome, r.Feedback, r.CreatedAt, r.UpdatedAt = *outcome, *feedback, *createdAt, *updatedAt
+		if r.Expired(now) {
+			// The sweep records expiry shortly; until then the applicant already sees it
+			expired := models.OfferExpired
+			r.OfferStatus = &expired
+		}
+		my.Result = &r
+		return my, nil
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan your results", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"results": results,
+		"count":   len(results),
+	})
+}
+
+// AcceptOffer handles POST /applications/:id/offer/accept - accepts a published offer before its deadline
+func AcceptOffer(c *gin.Context) {
+	respondToOffer(c, models.OfferAccepted)
+}
+
+// DeclineOffer handles POST /applications/:id/offer/decline - declines a published offer before its deadline
+func DeclineOffer(c *gin.Context) {
+	respondToOffer(c, models.OfferDeclined)
+}
+
+// respondToOffer records the applicant's answer to the offer on one of their applications
+func respondToOffer(c *gin.Context, status string) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start transaction", "details": err.Error()})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	var ownerID uuid.UUID
+	var published bool
+	result, err := scanApplicationResult(tx.QueryRow(ctx, queries.GetOfferForUpdateQuery, applicationID), &ownerID, &published)
+	// Applicants cannot tell an unpublished result from a missing one
+	if err != nil && err.Error() != "no rows in result set" {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch offer", "details": err.Error()})
+		return
+	}
+	if err != nil || ownerID != userID || !published || result.OfferStatus == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Offer not found"})
+		return
+	}
+	now := time.Now()
+	if result.Expired(now) {
+		c.JSON(http.StatusConflict, gin.H{"error": "The response deadline for this offer has passed"})
+		return
+	}
+	if *result.OfferStatus != models.OfferPending {
+		c.JSON(http.StatusConflict, gin.H{"error": "This offer has already been answered", "offer_status": *result.OfferStatus})
+		return
+	}
+
+	result, err = scanApplicationResult(tx.QueryRow(ctx, queries.RespondToOfferQuery, applicationID, status, now))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record your response", "details": err.Error()})
+		return
+	}
+
+	var app models.Application
+	err = tx.QueryRow(ctx, queries.GetApplicationByIDQuery, applicationID).Scan(
+		&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference, &app.CreatedAt, &app.UpdatedAt,
+	)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch application", "details": err.Error()})
+		return
+	}
+	previousStatus := app.Status
+	if status == models.OfferDeclined {
+		// Declining gives the seat up, so the application is released
+		err = tx.QueryRow(ctx, queries.UpdateApplicationStatusQuery, applicationID, models.ApplicationStatusReleased, now).Scan(
+			&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference, &app.CreatedAt, &app.UpdatedAt,
+		)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to release application", "details": err.Error()})
+			return
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record your response", "details": err.Error()})
+		return
+	}
+
+	event := models.EventOfferAccepted
+	if status == models.OfferDeclined {
+		event = models.EventOfferDeclined
+	}
+	services.PublishEvent(event, models.OfferResponse{Application: app, Result: result})
+	if app.Status != previousStatus {
+		services.PublishEvent(models.EventApplicationStatusChanged, models.ApplicationStatusChange{
+			Application:    app,
+			PreviousStatus: previousStatus,
+			Status:         app.Status,
+		})
+	}
+
+	if status == models.OfferDeclined {
+		// The freed seat goes to the waitlist; should this fail, the results sweep promotes on its next run
+		services.PromoteWaitlist(ctx, app.Department)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Response recorded successfully",
+		"result":  result,
+	})
+}
+
+// stageDepartmentResults snapshots a department's decisions as results in one transaction
+func stageDepartmentResults(ctx context.Context, department string, req models.StageResultsRequest, adminID uuid.UUID) (models.DepartmentResults, error) {
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		return models.DepartmentResults{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	existing, err := scanDepartmentResults(tx.QueryRow(ctx, queries.GetDepartmentResultsQuery+" FOR UPDATE", department))
+	if err == nil && existing.Published {
+		return existing, errResultsPublished
+	}
+	if err != nil && err.Error() != "no rows in result set" {
+		return existing, err
+	}
+
+	now := time.Now()
+	results, err := scanDepartmentResults(tx.QueryRow(ctx, queries.UpsertDepartmentResultsQuery,
+		department, req.PublishAt, req.ResponseDeadline, adminID, now,
+	))
+	if err != nil {
+		return results, err
+	}
+	if _, err := tx.Exec(ctx, queries.StageApplicationResultsQuery, department, req.ResponseDeadline, now); err != nil {
+		return results, err
+	}
+	if _, err := tx.Exec(ctx, queries.DeleteStaleApplicationResultsQuery, department); err != nil {
+		return results, err
+	}
+	if _, err := tx.Exec(ctx, queries.RenumberWaitlistQuery, department); err != nil {
+		return results, err
+	}
+	for applicationID, feedback := range req.Feedback {
+		result, err := tx.Exec(ctx, queries.SetDepartmentResultFeedbackQuery, applicationID, feedback, now, department)
+		if err != nil {
+			return results, err
+		}
+		if result.RowsAffected() == 0 {
+			return results, errFeedbackNotStaged
+		}
+	}
+
+	return results, tx.Commit(ctx)
+}
+
+// respondDepartmentResults writes a department's staged publication with its results and how many are undecided
+func respondDepartmentResults(c *gin.Context, ctx context.Context, results models.DepartmentResults, message string) {
+	rows, err := services.DB.Query(ctx, queries.GetDepartmentApplicationResultsQuery, results.Department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch results", "details": err.Error()})
+		return
+	}
+	entries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ApplicationResult, error) {
+		return scanApplicationResult(row)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan results", "details": err.Error()})
+		return
+	}
+
+	var undecided int
+	if err := services.DB.QueryRow(ctx, queries.CountUndecidedApplicationsQuery, results.Department).Scan(&undecided); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to count undecided applications", "details": err.Error()})
+		return
+	}
+
+	counts := map[string]int{}
+	for _, entry := range entries {
+		counts[entry.Outcome]++
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   message,
+		"results":   results,
+		"entries":   entries,
+		"outcomes":  counts,
+		"undecided": undecided, // Submitted applications without a decision get no result
+	})
+}
+
+// requireResultsUnpublished writes a 409 once a department's results are published. Decisions are final from then
+// on; changing an application's status would leave it out of step with its published result and offer.
+func requireResultsUnpublished(c *gin.Context, ctx context.Context, department string) bool {
+	published, err := services.ResultsPublished(ctx, department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check results publication", "details": err.Error()})
+		return false
+	}
+	if published {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":      "Results for this department have already been published; decisions can no longer change",
+			"department": department,
+		})
+		return false
+	}
+	return true
+}
+
+// maskUnpublishedDecisions hides decisions from an applicant until their department's results are published
+func maskUnpublishedDecisions(ctx context.Context, applications []models.Application) error {
+	rows, err := services.DB.Query(ctx, queries.GetUnpublishedDepartmentsQuery)
+	if err != nil {
+		return err
+	}
+	unpublished, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+
+	for i := range applications {
+		app := &applications[i]
+		if slices.Contains(unpublished, app.Department) && slices.Contains(models.ResultOutcomes, app.Status) {
+			app.Status = models.ApplicationStatusSubmitted
+		}
+	}
+	return nil
+}
+
+// maskUnpublishedWithdrawal hides the decision a withdrawn or reinstated application had, and has, from the applicant
+// until the department's results are published
+func maskUnpublishedWithdrawal(ctx context.Context, application *models.Application, withdrawal *models.ApplicationWithdrawal) error {
+	var err error
+	if application.Status, err = services.ApplicantStatus(ctx, application.Department, application.Status); err != nil {
+		return err
+	}
+	withdrawal.PreviousStatus, err = services.ApplicantStatus(ctx, application.Department, withdrawal.PreviousStatus)
+	return err
+}
+
+// scanDepartmentResults scans a row selected with the department results columns
+func scanDepartmentResults(row pgx.Row) (models.DepartmentResults, error) {
+	var r models.DepartmentResults
+	err := row.Scan(&r.Department, &r.PublishAt, &r.ResponseDeadline, &r.Published, &r.AnnouncedAt, &r.StagedBy, &r.CreatedAt, &r.UpdatedAt)
+	return r, err
+}
+
+// scanApplicationResult scans a row selected with the application result columns, followed by any extra columns
+func scanApplicationResult(row pgx.Row, extra ...any) (models.ApplicationResult, error) {
+	var r models.ApplicationResult
+	dest := []any{
+		&r.ApplicationID, &r.Department, &r.Outcome, &r.Feedback, &r.OfferStatus, &r.ResponseDeadline, &r.RespondedAt,
+		&r.WaitlistPosition, &r.PromotedAt, &r.CreatedAt, &r.UpdatedAt,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	return r, err
+}
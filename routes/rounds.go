@@ -0,0 +1,286 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// GetDepartmentRounds handles GET /admin/departments/:slug/rounds - lists a department's rounds in order (admin+)
+func GetDepartmentRounds(c *gin.Context) {
+	department := c.Param("slug")
+	if !requireDepartment(c, department) {
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetDepartmentRoundsQuery, department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch rounds", "details": err.Error()})
+		return
+	}
+	rounds, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Round, error) {
+		return scanRound(row)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan rounds", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"rounds": rounds})
+}
+
+// CreateRound handles POST /admin/departments/:slug/rounds - adds a round at the end of a department's pipeline (admin+)
+func CreateRound(c *gin.Context) {
+	department := c.Param("slug")
+
+	var req models.CreateRoundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	if !requireDepartment(c, department) {
+		return
+	}
+
+	ctx := context.Background()
+	round, err := scanRound(services.DB.QueryRow(ctx, queries.CreateRoundQuery, uuid.New(), department, req.Name, req.Description, time.Now()))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create round", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Round created successfully",
+		"round":   round,
+	})
+}
+
+// UpdateRound handles PUT /admin/rounds/:id - renames a round (admin+)
+func UpdateRound(c *gin.Context) {
+	roundID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round ID format"})
+		return
+	}
+
+	var req models.UpdateRoundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+	round, err := scanRound(services.DB.QueryRow(ctx, queries.UpdateRoundQuery, roundID, req.Name, req.Description, time.Now()))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Round not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update round", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Round updated successfully",
+		"round":   round,
+	})
+}
+
+// DeleteRound handles DELETE /admin/rounds/:id - removes a round that has no questions and no admitted applicants (admin+)
+func DeleteRound(c *gin.Context) {
+	roundID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.DeleteRoundQuery, roundID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			c.JSON(http.StatusConflict, gin.H{"error": "Round still has questions or admitted applicants"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete round", "details": err.Error()})
+		return
+	}
+	if result.RowsAffected() == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Round not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Round deleted successfully"})
+}
+
+// AdvanceApplications handles POST /admin/rounds/:id/advance - admits applications to a round in bulk (admin+).
+// Each application must belong to the round's department, still be under review and have been admitted to the previous round;
+// the others are reported as skipped.
+func AdvanceApplications(c *gin.Context) {
+	roundID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round ID format"})
+		return
+	}
+
+	var req models.AdvanceApplicationsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+	round, err := scanRound(services.DB.QueryRow(ctx, queries.GetRoundByIDQuery, roundID))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Round not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch round", "details": err.Error()})
+		return
+	}
+
+	previous, err := scanRound(services.DB.QueryRow(ctx, queries.GetPreviousRoundQuery, roundID))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Applicants are admitted to the first round when they apply"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch previous round", "details": err.Error()})
+		return
+	}
+
+	rows, err := services.DB.Query(ctx, queries.GetAdvanceCandidatesQuery, roundID, req.ApplicationIDs, previous.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch applications", "details": err.Error()})
+		return
+	}
+	type candidate struct {
+		application models.Application
+		inPrevious  bool
+		inRound     bool
+	}
+	candidates := map[uuid.UUID]candidate{}
+	for rows.Next() {
+		var cand candidate
+		app := &cand.application
+		if err := rows.Scan(
+			&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference, &app.CreatedAt, &app.UpdatedAt,
+			&cand.inPrevious, &cand.inRound,
+		); err != nil {
+			rows.Close()
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan application", "details": err.Error()})
+			return
+		}
+		candidates[app.ID] = cand
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Error occurred while reading applications", "details": err.Error()})
+		return
+	}
+
+	result := models.AdvanceApplicationsResult{Round: round, Advanced: []uuid.UUID{}, Skipped: []models.SkippedApplication{}}
+	var advanced []models.Application
+	seen := map[uuid.UUID]bool{}
+	for _, id := range req.ApplicationIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		cand, ok := candidates[id]
+		reason := ""
+		switch {
+		case !ok:
+			reason = "application not found"
+		case cand.application.Department != round.Department:
+			reason = "application belongs to another department"
+		case !cand.application.Reviewable() || cand.application.Status == models.ApplicationStatusRejected ||
+			cand.application.Status == models.ApplicationStatusReleased:
+			reason = "application is not under review"
+		case cand.inRound:
+			reason = "application is already in this round"
+		case !cand.inPrevious:
+			reason = "application has not been admitted to the previous round"
+		}
+		if reason != "" {
+			result.Skipped = append(result.Skipped, models.SkippedApplication{ApplicationID: id, Reason: reason})
+			continue
+		}
+		result.Advanced = append(result.Advanced, id)
+		advanced = append(advanced, cand.application)
+	}
+
+	if len(result.Advanced) > 0 {
+		adminID, _ := c.Get("userID")
+		if _, err := services.DB.Exec(ctx, queries.AdmitApplicationsToRoundQuery, roundID, result.Advanced, adminID, time.Now()); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to advance applications", "details": err.Error()})
+			return
+		}
+	}
+
+	for _, app := range advanced {
+		services.PublishEvent(models.EventApplicationAdvanced, models.ApplicationAdvance{Application: app, Round: round})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// requireRound responds with an error and returns false unless the round belongs to the department
+func requireRound(c *gin.Context, department string, roundID uuid.UUID) bool {
+	round, err := scanRound(services.DB.QueryRow(c.Request.Context(), queries.GetRoundByIDQuery, roundID))
+	if err != nil && err.Error() != "no rows in result set" {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to validate round", "details": err.Error()})
+		return false
+	}
+	if err != nil || round.Department != department {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round for this department", "round_id": roundID})
+		return false
+	}
+	return true
+}
+
+// attachApplicationRounds fills in the rounds each of a user's applications has been admitted to
+func attachApplicationRounds(ctx context.Context, userID uuid.UUID, applications []models.Application) error {
+	rows, err := services.DB.Query(ctx, queries.GetUserApplicationRoundsQuery, userID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	byApplication := map[uuid.UUID][]models.ApplicationRound{}
+	for rows.Next() {
+		var applicationID uuid.UUID
+		var round models.ApplicationRound
+		if err := rows.Scan(&applicationID, &round.RoundID, &round.Name, &round.Description, &round.Position, &round.AdmittedAt); err != nil {
+			return err
+		}
+		byApplication[applicationID] = append(byApplication[applicationID], round)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range applications {
+		applications[i].Rounds = byApplication[applications[i].ID]
+	}
+	return nil
+}
+
+// scanRound scans a row selected with the round columns
+func scanRound(row pgx.Row) (models.Round, error) {
+	var r models.Round
+	err := row.Scan(&r.ID, &r.Department, &r.Name, &r.Description, &r.Position, &r.CreatedAt, &r.UpdatedAt)
+	return r, err
+}
@@ -0,0 +1,250 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Result limits for answer search
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// SearchAnswers handles GET /search/answers?q= - ranked full-text search across submitted answers (evaluator+)
+func SearchAnswers(c *gin.Context) {
+	query := strings.TrimSpace(c.Query("q"))
+	if query == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Search query parameter q is required"})
+		return
+	}
+
+	limit := defaultSearchLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxSearchLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit. Must be between 1 and 100"})
+			return
+		}
+		limit = parsed
+	}
+
+	department := c.Query("department")
+	if department != "" && !requireDepartment(c, department) {
+		return
+	}
+
+	// Admins see every department; evaluators are limited to their assigned departments, if any
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.SearchAnswersQuery, query, reviewScope(c), department, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to search answers",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	results := []models.AnswerSearchResult{}
+	for rows.Next() {
+		var r models.AnswerSearchResult
+		err := rows.Scan(
+			&r.AnswerID, &r.ApplicationID, &r.Blind, &r.UserID, &r.FullName, &r.RegNum,
+			&r.QuestionID, &r.Question, &r.Department, &r.Snippet, &r.Rank,
+		)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan search result",
+				"details": err.Error(),
+			})
+			return
+		}
+		r.Snippet = models.HighlightSnippet(r.Snippet)
+		r.Anonymise()
+		results = append(results, r)
+	}
+
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading search results",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Search completed successfully",
+		"query":   query,
+		"results": results,
+		"count":   len(results),
+	})
+}
+
+// GetEvaluatorDepartments handles GET /admin/evaluators/:id/departments - lists the departments an evaluator may access
+func GetEvaluatorDepartments(c *gin.Context) {
+	evaluatorID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	departments, err := services.EvaluatorDepartments(context.Background(), evaluatorID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch evaluator departments",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":      "Evaluator departments fetched successfully",
+		"user_id":      evaluatorID,
+		"departments":  departments,
+		"unrestricted": len(departments) == 0,
+	})
+}
+
+// SetEvaluatorDepartments handles PUT /admin/evaluators/:id/departments - replaces the departments an evaluator may access.
+// An empty list removes the restriction.
+func SetEvaluatorDepartments(c *gin.Context) {
+	evaluatorID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID format"})
+		return
+	}
+
+	var req models.SetEvaluatorDepartmentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	departments := make([]string, 0, len(req.Departments))
+	for _, department := range req.Departments {
+		if !requireDepartment(c, department) {
+			return
+		}
+		if !slices.Contains(departments, department) {
+			departments = append(departments, department)
+		}
+	}
+
+	ctx := context.Background()
+
+	var user models.User
+	err = services.DB.QueryRow(ctx, queries.GetUserByIDQuery, evaluatorID).Scan(
+		&user.ID, &user.FullName, &user.Email, &user.RegNum, &user.PhoneNumber, &user.Verified,
+		&user.Role, &user.ChickenedOut, &user.CreatedAt, &user.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch user",
+			"details": err.Error(),
+		})
+		return
+	}
+	if user.Role != models.RoleEvaluator {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Department restrictions only apply to evaluators"})
+		return
+	}
+
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to start transaction",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, queries.DeleteEvaluatorDepartmentsQuery, evaluatorID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to clear evaluator departments",
+			"details": err.Error(),
+		})
+		return
+	}
+	if len(departments) > 0 {
+		if _, err := tx.Exec(ctx, queries.InsertEvaluatorDepartmentsQuery, evaluatorID, departments); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to save evaluator departments",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to commit evaluator departments",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":      "Evaluator departments updated successfully",
+		"user_id":      evaluatorID,
+		"departments":  req.Departments,
+		"unrestricted": len(departments) == 0,
+	})
+}
+
+// reviewScope returns the caller's review scope, see services.ReviewScope
+func reviewScope(c *gin.Context) *uuid.UUID {
+	role, _ := c.MustGet("userRole").(models.UserRole)
+	return services.ReviewScope(c.MustGet("userID").(uuid.UUID), role)
+}
+
+// requireReviewableApplication writes a 404 unless the caller may review the application's department.
+// Applications outside an evaluator's departments are reported as missing rather than forbidden.
+func requireReviewableApplication(c *gin.Context, applicationID uuid.UUID) bool {
+	allowed, err := services.CanReviewApplication(c.Request.Context(), reviewScope(c), applicationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to check department access",
+			"details": err.Error(),
+		})
+		return false
+	}
+	if !allowed {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Application not found"})
+		return false
+	}
+	return true
+}
+
+// requireReviewableDepartment writes a 403 unless the caller may review the department
+func requireReviewableDepartment(c *gin.Context, department string) bool {
+	allowed, err := services.CanReviewDepartment(c.Request.Context(), reviewScope(c), department)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to check department access",
+			"details": err.Error(),
+		})
+		return false
+	}
+	if !allowed {
+		c.JSON(http.StatusForbidden, gin.H{"error": "You do not review this department", "department": department})
+		return false
+	}
+	return true
+}
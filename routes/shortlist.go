@@ -0,0 +1,241 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"gopkg.in/gomail.v2"
+)
+
+// errShortlistStale is returned when an application's status changed between ranking and committing
+var errShortlistStale = errors.New("an application changed status while the shortlist was being committed")
+
+// BuildShortlist handles POST /admin/departments/:slug/shortlist - ranks a department's scored applications and
+// shortlists the top ones (admin+). With dry_run the ranking is returned without changes; otherwise the statuses
+// are moved in one transaction and, with notify, the affected applicants are emailed. Decisions are emailed when the
+// department's results are published, since applicants only learn of them then; once they are, the shortlist is closed.
+func BuildShortlist(c *gin.Context) {
+	slug := c.Param("slug")
+
+	var req models.ShortlistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	if req.Score == "" {
+		req.Score = models.ShortlistScoreRaw
+	}
+	if req.TieBreakers == nil {
+		req.TieBreakers = models.DefaultShortlistTieBreakers
+	}
+	for _, tieBreaker := range req.TieBreakers {
+		if !slices.Contains(models.ShortlistTieBreakers, tieBreaker) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid tie-breaker", "tie_breakers": models.ShortlistTieBreakers})
+			return
+		}
+	}
+	if req.Status == "" {
+		req.Status = models.ApplicationStatusSelected
+	}
+	if !slices.Contains(models.ShortlistStatuses, req.Status) ||
+		(req.RemainderStatus != "" && !slices.Contains(models.ShortlistStatuses, req.RemainderStatus)) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid status", "statuses": models.ShortlistStatuses})
+		return
+	}
+
+	ctx := context.Background()
+	department, err := fetchDepartment(ctx, slug)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Department not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch department", "details": err.Error()})
+		return
+	}
+	capacity := req.Capacity
+	if capacity == nil {
+		capacity = department.Capacity
+	}
+
+	candidates, unscored, err := fetchShortlistCandidates(ctx, slug, req.Score)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch applications", "details": err.Error()})
+		return
+	}
+
+	result := models.ShortlistResult{
+		Department:  slug,
+		Score:       req.Score,
+		Capacity:    capacity,
+		MinScore:    req.MinScore,
+		TieBreakers: req.TieBreakers,
+		Entries:     services.RankShortlist(candidates, req.Score, req.TieBreakers, capacity, req.MinScore),
+		Unscored:    unscored,
+		DryRun:      req.DryRun,
+	}
+	for i := range result.Entries {
+		entry := &result.Entries[i]
+		status := req.RemainderStatus
+		if entry.Shortlisted {
+			result.Shortlisted++
+			status = req.Status
+		}
+		if status != "" && status != entry.Status {
+			entry.NewStatus = status
+		}
+	}
+
+	if req.DryRun {
+		c.JSON(http.StatusOK, result)
+		return
+	}
+
+	if !requireResultsUnpublished(c, ctx, slug) {
+		return
+	}
+
+	changed, err := applyShortlist(ctx, result.Entries, req.Notify)
+	if err != nil {
+		if errors.Is(err, errShortlistStale) {
+			c.JSON(http.StatusConflict, gin.H{"error": "Applications changed while shortlisting; preview the shortlist again"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to apply shortlist", "details": err.Error()})
+		return
+	}
+	result.Changed = len(changed)
+	for _, change := range changed {
+		services.PublishEvent(models.EventApplicationStatusChanged, change)
+	}
+
+	if req.Notify {
+		emails := map[uuid.UUID]string{}
+		for _, candidate := range candidates {
+			emails[candidate.ApplicationID] = candidate.Email
+		}
+		var messages []*gomail.Message
+		for _, change := range changed {
+			// Applicants learn of decisions when the department's results are published, not from shortlisting;
+			// applyShortlist has marked them to be emailed then
+			if slices.Contains(models.ResultOutcomes, change.Status) {
+				result.Withheld++
+				continue
+			}
+			if m := statusChangeMessage(emails[change.Application.ID], change.Application.Department); m != nil {
+				messages = append(messages, m)
+			}
+		}
+		result.Notified = len(messages)
+		// The mailer takes one message at a time, so the batch is handed over in the background
+		go func() {
+			for _, m := range messages {
+				services.Mailer <- m
+			}
+		}()
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// fetchShortlistCandidates loads a department's applications in contention with their scores.
+// Applications without evaluations are returned separately since they cannot be ranked.
+func fetchShortlistCandidates(ctx context.Context, department, score string) ([]models.ShortlistCandidate, []uuid.UUID, error) {
+	rows, err := services.DB.Query(ctx, queries.GetShortlistCandidatesQuery, department)
+	if err != nil {
+		return nil, nil, err
+	}
+	all, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ShortlistCandidate, error) {
+		var s models.ShortlistCandidate
+		err := row.Scan(&s.ApplicationID, &s.UserID, &s.Email, &s.Status, &s.Preference, &s.SubmittedAt, &s.Evaluations, &s.RawScore)
+		return s, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	normalised := map[uuid.UUID]float64{}
+	if score == models.ShortlistScoreNormalised {
+		scores, err := fetchCalibrationScores(ctx, department)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, application := range services.CalibrateScores(scores, models.DefaultOutlierThreshold).Applications {
+			normalised[application.ApplicationID] = application.NormalisedScore
+		}
+	}
+
+	candidates := []models.ShortlistCandidate{}
+	unscored := []uuid.UUID{}
+	for _, candidate := range all {
+		if candidate.Evaluations == 0 {
+			unscored = append(unscored, candidate.ApplicationID)
+			continue
+		}
+		candidate.NormalisedScore = normalised[candidate.ApplicationID]
+		candidates = append(candidates, candidate)
+	}
+	return candidates, unscored, nil
+}
+
+// applyShortlist moves every entry with a new status in one transaction. With notify, applicants given a decision
+// are marked to be emailed when the department's results are published.
+func applyShortlist(ctx context.Context, entries []models.ShortlistEntry, notify bool) ([]models.ApplicationStatusChange, error) {
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	var changed []models.ApplicationStatusChange
+	for _, entry := range entries {
+		if entry.NewStatus == "" {
+			continue
+		}
+		var app models.Application
+		emailOnResults := notify && slices.Contains(models.ResultOutcomes, entry.NewStatus)
+		err := tx.QueryRow(ctx, queries.SetShortlistStatusQuery, entry.ApplicationID, entry.NewStatus, now, entry.Status, emailOnResults).Scan(
+			&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference,
+			&app.CreatedAt, &app.UpdatedAt,
+		)
+		if err != nil {
+			if err.Error() == "no rows in result set" {
+				return nil, errShortlistStale
+			}
+			return nil, err
+		}
+		changed = append(changed, models.ApplicationStatusChange{
+			Application:    app,
+			PreviousStatus: entry.Status,
+			Status:         entry.NewStatus,
+		})
+	}
+
+	return changed, tx.Commit(ctx)
+}
+
+// statusChangeMessage builds the email telling an applicant their application status changed; nil without an address
+func statusChangeMessage(email, department string) *gomail.Message {
+	if email == "" {
+		return nil
+	}
+
+	emailTemplate := utils.GetApplicationStatusTemplate(department)
+	m := gomail.NewMessage()
+	m.SetHeader("From", utils.GetEnvWithDefault("EMAIL_FROM", "recruitments@no-reply.ieeecsvitc.com"))
+	m.SetHeader("To", email)
+	m.SetHeader("Subject", emailTemplate.Subject)
+	m.SetBody("text/html", emailTemplate.Body)
+	return m
+}
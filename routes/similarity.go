@@ -0,0 +1,127 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetSimilarityFlags handles GET /admin/similarity - lists pairs of near-identical answers (?min=0.8&department=&limit=)
+func GetSimilarityFlags(c *gin.Context) {
+	minSimilarity := 0.0
+	if raw := c.Query("min"); raw != "" {
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil || parsed < 0 || parsed > 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid min. Must be between 0 and 1"})
+			return
+		}
+		minSimilarity = parsed
+	}
+
+	limit := defaultPageLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit. Must be between 1 and 200"})
+			return
+		}
+		limit = parsed
+	}
+
+	department := c.Query("department")
+	if department != "" && !requireDepartment(c, department) {
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetSimilarityFlagsQuery, minSimilarity, department, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch similarity flags",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	flags, err := scanSimilarityFlags(rows)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to read similarity flags",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Similarity flags fetched successfully",
+		"flags":   flags,
+		"count":   len(flags),
+	})
+}
+
+// GetApplicationSimilarityFlags handles GET /applications/:id/similarity - lists answers of an application that resemble other applicants' answers (evaluator+)
+func GetApplicationSimilarityFlags(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	if !requireReviewableApplication(c, applicationID) {
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetApplicationSimilarityFlagsQuery, applicationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch similarity flags",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	flags, err := scanSimilarityFlags(rows)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to read similarity flags",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":        "Similarity flags fetched successfully",
+		"application_id": applicationID,
+		"flags":          flags,
+		"count":          len(flags),
+	})
+}
+
+// scanSimilarityFlags reads rows produced by the similarity flag queries and closes them
+func scanSimilarityFlags(rows pgx.Rows) ([]models.SimilarityFlag, error) {
+	defer rows.Close()
+
+	flags := []models.SimilarityFlag{}
+	for rows.Next() {
+		var f models.SimilarityFlag
+		err := rows.Scan(
+			&f.ID, &f.QuestionID, &f.Question, &f.Department, &f.Similarity, &f.CheckedAt, &f.CreatedAt,
+			&f.A.AnswerID, &f.A.ApplicationID, &f.A.Blind, &f.A.UserID, &f.A.FullName, &f.A.RegNum, &f.A.Body,
+			&f.B.AnswerID, &f.B.ApplicationID, &f.B.Blind, &f.B.UserID, &f.B.FullName, &f.B.RegNum, &f.B.Body,
+		)
+		if err != nil {
+			return nil, err
+		}
+		f.A.Anonymise()
+		f.B.Anonymise()
+		flags = append(flags, f)
+	}
+	return flags, rows.Err()
+}
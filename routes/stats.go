@@ -0,0 +1,121 @@
+package routes
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// statsCache keeps computed dashboards briefly so repeated refreshes don't re-run the aggregates
+var statsCache = utils.NewTTLCache[models.RecruitmentStats]()
+
+// GetRecruitmentStats handles GET /admin/stats - returns recruitment funnel metrics
+func GetRecruitmentStats(c *gin.Context) {
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days < 1 || days > 365 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Days must be between 1 and 365"})
+		return
+	}
+
+	cacheKey := strconv.Itoa(days)
+	stats, expiresAt, cached := statsCache.Get(cacheKey)
+	if !cached {
+		stats, err = computeRecruitmentStats(context.Background(), days)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to compute recruitment stats",
+				"details": err.Error(),
+			})
+			return
+		}
+		expiresAt = statsCache.Set(cacheKey, stats, utils.GetEnvAsDuration("STATS_CACHE_TTL", time.Minute))
+	}
+
+	maxAge := int(math.Ceil(time.Until(expiresAt).Seconds()))
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", maxAge))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Recruitment stats fetched successfully",
+		"stats":   stats,
+		"cached":  cached,
+	})
+}
+
+// computeRecruitmentStats runs the aggregate queries behind the admin dashboard
+func computeRecruitmentStats(ctx context.Context, days int) (models.RecruitmentStats, error) {
+	stats := models.RecruitmentStats{
+		Departments: []models.DepartmentStats{},
+		Daily:       []models.DailyStats{},
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	err := services.DB.QueryRow(ctx, queries.GetUserStatsQuery).Scan(
+		&stats.Users.Registrations, &stats.Users.Verified, &stats.Users.ChickenedOut, &stats.Users.WithoutApps,
+	)
+	if err != nil {
+		return stats, fmt.Errorf("failed to aggregate users: %w", err)
+	}
+
+	rows, err := services.DB.Query(ctx, queries.GetDepartmentStatsQuery)
+	if err != nil {
+		return stats, fmt.Errorf("failed to aggregate departments: %w", err)
+	}
+	for rows.Next() {
+		var dept models.DepartmentStats
+		if err := rows.Scan(
+			&dept.Department, &dept.ApplicationsCreated, &dept.ApplicationsSubmitted,
+			&dept.ApplicationsEvaluated, &dept.ChickenedOut, &dept.AverageAnswersPerApp,
+		); err != nil {
+			rows.Close()
+			return stats, fmt.Errorf("failed to scan department stats: %w", err)
+		}
+		if dept.ApplicationsSubmitted > 0 {
+			dept.EvaluationProgressPercent = math.Round(float64(dept.ApplicationsEvaluated)/float64(dept.ApplicationsSubmitted)*10000) / 100
+		}
+		dept.AverageAnswersPerApp = math.Round(dept.AverageAnswersPerApp*100) / 100
+		stats.Departments = append(stats.Departments, dept)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return stats, fmt.Errorf("failed to read department stats: %w", err)
+	}
+
+	err = services.DB.QueryRow(ctx, queries.GetEvaluationStatsQuery).Scan(
+		&stats.Evaluations.Total, &stats.Evaluations.Evaluators,
+		&stats.Evaluations.AverageScore, &stats.Evaluations.SubmittedPending,
+	)
+	if err != nil {
+		return stats, fmt.Errorf("failed to aggregate evaluations: %w", err)
+	}
+	stats.Evaluations.AverageScore = math.Round(stats.Evaluations.AverageScore*100) / 100
+
+	rows, err = services.DB.Query(ctx, queries.GetDailyStatsQuery, days)
+	if err != nil {
+		return stats, fmt.Errorf("failed to aggregate daily stats: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var day models.DailyStats
+		if err := rows.Scan(
+			&day.Date, &day.Registrations, &day.ApplicationsCreated,
+			&day.ApplicationsSubmitted, &day.Evaluations,
+		); err != nil {
+			return stats, fmt.Errorf("failed to scan daily stats: %w", err)
+		}
+		stats.Daily = append(stats.Daily, day)
+	}
+	if err := rows.Err(); err != nil {
+		return stats, fmt.Errorf("failed to read daily stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,537 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// GetRoundTasks handles GET /admin/rounds/:id/tasks - lists a round's tasks (admin+)
+func GetRoundTasks(c *gin.Context) {
+	roundID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetRoundTasksQuery, roundID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tasks", "details": err.Error()})
+		return
+	}
+	tasks, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Task, error) {
+		return scanTask(row)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan tasks", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"tasks": tasks})
+}
+
+// CreateTask handles POST /admin/rounds/:id/tasks - adds a take-home task to a round (admin+)
+func CreateTask(c *gin.Context) {
+	roundID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid round ID format"})
+		return
+	}
+
+	req, ok := bindTaskRequest(c)
+	if !ok {
+		return
+	}
+
+	adminID, _ := c.Get("userID")
+
+	ctx := context.Background()
+	task, err := scanTask(services.DB.QueryRow(ctx, queries.CreateTaskQuery,
+		uuid.New(), roundID, req.Title, req.Description, req.Attachments, req.Deadline, req.LateWindowMinutes, adminID, time.Now(),
+	))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Round not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create task", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Task created successfully",
+		"task":    task,
+	})
+}
+
+// UpdateTask handles PUT /admin/tasks/:id - replaces a task's details (admin+)
+func UpdateTask(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	req, ok := bindTaskRequest(c)
+	if !ok {
+		return
+	}
+
+	ctx := context.Background()
+	task, err := scanTask(services.DB.QueryRow(ctx, queries.UpdateTaskQuery,
+		taskID, req.Title, req.Description, req.Attachments, req.Deadline, req.LateWindowMinutes, time.Now(),
+	))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to update task", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task updated successfully",
+		"task":    task,
+	})
+}
+
+// DeleteTask handles DELETE /admin/tasks/:id - removes a task nobody has submitted yet (admin+)
+func DeleteTask(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.DeleteTaskQuery, taskID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			c.JSON(http.StatusConflict, gin.H{"error": "Task already has submissions"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete task", "details": err.Error()})
+		return
+	}
+	if result.RowsAffected() == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task deleted successfully"})
+}
+
+// GrantTaskExtension handles PUT /admin/tasks/:id/extensions/:application_id - gives one applicant a new deadline (admin+)
+func GrantTaskExtension(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+	applicationID, err := uuid.Parse(c.Param("application_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	var req models.GrantTaskExtensionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+	window, err := fetchTaskWindow(ctx, taskID, applicationID)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task or application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch task", "details": err.Error()})
+		return
+	}
+	if !window.sameDepartment {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Application belongs to another department"})
+		return
+	}
+
+	adminID, _ := c.Get("userID")
+
+	var extension models.TaskExtension
+	err = services.DB.QueryRow(ctx, queries.UpsertTaskExtensionQuery, taskID, applicationID, req.Deadline, req.Reason, adminID, time.Now()).Scan(
+		&extension.TaskID, &extension.ApplicationID, &extension.Deadline, &extension.Reason, &extension.GrantedBy, &extension.GrantedAt,
+	)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to grant extension", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":   "Extension granted successfully",
+		"extension": extension,
+	})
+}
+
+// RevokeTaskExtension handles DELETE /admin/tasks/:id/extensions/:application_id - restores the task's own deadline (admin+)
+func RevokeTaskExtension(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+	applicationID, err := uuid.Parse(c.Param("application_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.DeleteTaskExtensionQuery, taskID, applicationID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke extension", "details": err.Error()})
+		return
+	}
+	if result.RowsAffected() == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Extension not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Extension revoked successfully"})
+}
+
+// GetMyTasks handles GET /applications/me/tasks - lists the tasks of the rounds the user has been admitted to,
+// with their own deadline and submission
+func GetMyTasks(c *gin.Context) {
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetUserTasksQuery, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch tasks", "details": err.Error()})
+		return
+	}
+	tasks, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ApplicantTask, error) {
+		var t models.ApplicantTask
+		err := row.Scan(
+			&t.ID, &t.RoundID, &t.Department, &t.Title, &t.Description, &t.Attachments, &t.Deadline, &t.LateWindowMinutes,
+			&t.CreatedBy, &t.CreatedAt, &t.UpdatedAt, &t.ApplicationID, &t.DueAt,
+		)
+		t.ClosesAt = t.DueAt.Add(time.Duration(t.LateWindowMinutes) * time.Minute)
+		return t, err
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan tasks", "details": err.Error()})
+		return
+	}
+
+	rows, err = services.DB.Query(ctx, queries.GetUserTaskSubmissionsQuery, userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch task submissions", "details": err.Error()})
+		return
+	}
+	submissions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.TaskSubmission, error) {
+		return scanTaskSubmission(row)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan task submissions", "details": err.Error()})
+		return
+	}
+	for i := range tasks {
+		for j := range submissions {
+			if submissions[j].TaskID == tasks[i].ID && submissions[j].ApplicationID == tasks[i].ApplicationID {
+				tasks[i].Submission = &submissions[j]
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"tasks": tasks,
+		"count": len(tasks),
+	})
+}
+
+// SubmitTask handles PUT /applications/:id/tasks/:task_id/submission - submits or replaces the applicant's work for a task.
+// Submissions after the deadline are accepted within the task's late window and flagged as late.
+func SubmitTask(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+	taskID, err := uuid.Parse(c.Param("task_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	var req models.SubmitTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid submission", "details": err.Error()})
+		return
+	}
+
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	window, err := fetchTaskWindow(ctx, taskID, applicationID)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task or application not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch task", "details": err.Error()})
+		return
+	}
+	if window.application.UserID != userID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
+		return
+	}
+	if !window.sameDepartment || !window.admitted {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Task belongs to a round this application has not been admitted to"})
+		return
+	}
+	app := window.application
+	if !app.Reviewable() || app.Status == models.ApplicationStatusRejected || app.Status == models.ApplicationStatusReleased {
+		c.JSON(http.StatusConflict, gin.H{"error": "Application is no longer under review"})
+		return
+	}
+
+	now := time.Now()
+	if now.After(window.closesAt()) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":     "Submission window has closed",
+			"due_at":    window.dueAt,
+			"closes_at": window.closesAt(),
+		})
+		return
+	}
+
+	var deployURL *string
+	if req.DeployURL != "" {
+		deployURL = &req.DeployURL
+	}
+	submission, err := scanTaskSubmission(services.DB.QueryRow(ctx, queries.UpsertTaskSubmissionQuery,
+		uuid.New(), taskID, applicationID, userID, req.RepositoryURL, deployURL, req.Notes, now.After(window.dueAt), now,
+	))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save submission", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Task submitted successfully",
+		"submission": submission,
+	})
+}
+
+// GetTaskSubmissions handles GET /tasks/:id/submissions - lists a task's submissions with their grades (evaluator+)
+func GetTaskSubmissions(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	task, err := scanTask(services.DB.QueryRow(ctx, queries.GetTaskByIDQuery, taskID))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch task", "details": err.Error()})
+		return
+	}
+	if !requireReviewableDepartment(c, task.Department) {
+		return
+	}
+
+	rows, err := services.DB.Query(ctx, queries.GetTaskSubmissionsQuery, taskID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch submissions", "details": err.Error()})
+		return
+	}
+	submissions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.TaskSubmission, error) {
+		var blind bool
+		s, err := scanTaskSubmission(row, &blind)
+		s.Blind = blind
+		s.Anonymise()
+		return s, err
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan submissions", "details": err.Error()})
+		return
+	}
+
+	rows, err = services.DB.Query(ctx, queries.GetTaskGradesQuery, taskID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch grades", "details": err.Error()})
+		return
+	}
+	grades, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.TaskGrade, error) {
+		return scanTaskGrade(row)
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan grades", "details": err.Error()})
+		return
+	}
+	for i := range submissions {
+		for _, grade := range grades {
+			if grade.SubmissionID == submissions[i].ID {
+				submissions[i].Grades = append(submissions[i].Grades, grade)
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"task":        task,
+		"submissions": submissions,
+		"count":       len(submissions),
+	})
+}
+
+// GradeTaskSubmission handles POST /tasks/:id/submissions/:submission_id/grades - records the current evaluator's grade (evaluator+)
+func GradeTaskSubmission(c *gin.Context) {
+	taskID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task ID format"})
+		return
+	}
+	submissionID, err := uuid.Parse(c.Param("submission_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid submission ID"})
+		return
+	}
+
+	var req models.GradeTaskSubmissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	evaluatorID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	submission, err := scanTaskSubmission(services.DB.QueryRow(ctx, queries.GetTaskSubmissionByIDQuery, submissionID))
+	if err != nil && err.Error() != "no rows in result set" {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch submission", "details": err.Error()})
+		return
+	}
+	if err != nil || submission.TaskID != taskID {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Submission not found"})
+		return
+	}
+	if !requireReviewableApplication(c, submission.ApplicationID) {
+		return
+	}
+
+	grade, err := scanTaskGrade(services.DB.QueryRow(ctx, queries.UpsertTaskGradeQuery, submissionID, evaluatorID, *req.Score, req.Notes))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to record grade", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Grade recorded successfully",
+		"grade":   grade,
+	})
+}
+
+// taskWindow is what decides whether an application may submit a task
+type taskWindow struct {
+	application       models.Application
+	sameDepartment    bool
+	admitted          bool
+	dueAt             time.Time
+	lateWindowMinutes int
+}
+
+// closesAt is the last moment a late submission is accepted
+func (w taskWindow) closesAt() time.Time {
+	return w.dueAt.Add(time.Duration(w.lateWindowMinutes) * time.Minute)
+}
+
+// fetchTaskWindow loads an application's standing and deadline for a task
+func fetchTaskWindow(ctx context.Context, taskID, applicationID uuid.UUID) (taskWindow, error) {
+	w := taskWindow{application: models.Application{ID: applicationID}}
+	err := services.DB.QueryRow(ctx, queries.GetTaskApplicationWindowQuery, taskID, applicationID).Scan(
+		&w.application.UserID, &w.application.Status, &w.sameDepartment, &w.admitted, &w.dueAt, &w.lateWindowMinutes,
+	)
+	return w, err
+}
+
+// bindTaskRequest binds and validates a task body, writing the error response when it is invalid
+func bindTaskRequest(c *gin.Context) (models.TaskRequest, bool) {
+	var req models.TaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return req, false
+	}
+	if err := req.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task", "details": err.Error()})
+		return req, false
+	}
+	if req.Attachments == nil {
+		req.Attachments = []models.TaskAttachment{}
+	}
+	return req, true
+}
+
+// scanTask scans a row selected with the task columns
+func scanTask(row pgx.Row) (models.Task, error) {
+	var t models.Task
+	err := row.Scan(
+		&t.ID, &t.RoundID, &t.Department, &t.Title, &t.Description, &t.Attachments, &t.Deadline, &t.LateWindowMinutes,
+		&t.CreatedBy, &t.CreatedAt, &t.UpdatedAt,
+	)
+	return t, err
+}
+
+// scanTaskSubmission scans a row selected with the task submission columns, followed by any extra columns
+func scanTaskSubmission(row pgx.Row, extra ...any) (models.TaskSubmission, error) {
+	var s models.TaskSubmission
+	dest := []any{
+		&s.ID, &s.TaskID, &s.ApplicationID, &s.UserID, &s.RepositoryURL, &s.DeployURL, &s.Notes, &s.Late, &s.SubmittedAt, &s.UpdatedAt,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	return s, err
+}
+
+// scanTaskGrade scans a task grade row
+func scanTaskGrade(row pgx.Row) (models.TaskGrade, error) {
+	var g models.TaskGrade
+	err := row.Scan(&g.ID, &g.SubmissionID, &g.EvaluatorID, &g.Score, &g.Notes, &g.CreatedAt, &g.UpdatedAt)
+	return g, err
+}
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/ComputerSocietyVITC/recruitment-backend/models"
@@ -107,10 +108,43 @@ func CreateUser(c *gin.Context) {
 	})
 }
 
-// GetAllUsers handles GET /users - fetches all users
+// GetAllUsers handles GET /users - lists users with filtering, sorting and cursor pagination
 func GetAllUsers(c *gin.Context) {
+	page, err := parsePageRequest(c, queries.UserSortFields, "created_at", true)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid pagination parameters", "details": err.Error()})
+		return
+	}
+
+	list := queries.NewListQuery(queries.ListUsersColumns, queries.ListUsersFrom, "id")
+	if role := c.Query("role"); role != "" {
+		switch models.UserRole(role) {
+		case models.RoleApplicant, models.RoleEvaluator, models.RoleAdmin, models.RoleSuperAdmin:
+			list.Where("role = ?", role)
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid role filter"})
+			return
+		}
+	}
+	err = errors.Join(
+		filterBool(c, list, "verified", "verified"),
+		filterBool(c, list, "chickened_out", "chickened_out"),
+		filterCreatedRange(c, list, "created_at"),
+	)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid filter parameters", "details": err.Error()})
+		return
+	}
+	filterSearch(c, list, "full_name", "email", "reg_num")
+	// Evaluators cannot list applicants under blind review, which would let them match names to applications
+	if role, _ := c.Get("userRole"); role == models.RoleEvaluator {
+		list.Where(queries.NotUnderBlindReviewCondition)
+	}
+
+	sql, args := list.Build(page.Sort, page.Desc, page.After, page.Limit)
+
 	ctx := context.Background()
-	rows, err := services.DB.Query(ctx, queries.GetAllUsersQuery)
+	rows, err := services.DB.Query(ctx, sql, args...)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error":   "Failed to fetch users",
@@ -120,12 +154,14 @@ func GetAllUsers(c *gin.Context) {
 	}
 	defer rows.Close()
 
-	var users []models.UserResponse
+	users := []models.UserResponse{}
+	var keys []string
 	for rows.Next() {
 		var user models.User
+		var key string
 		err := rows.Scan(
 			&user.ID, &user.FullName, &user.Email, &user.RegNum, &user.PhoneNumber, &user.Verified,
-			&user.Role, &user.ChickenedOut, &user.CreatedAt, &user.UpdatedAt,
+			&user.Role, &user.ChickenedOut, &user.CreatedAt, &user.UpdatedAt, &key,
 		)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{
@@ -135,6 +171,7 @@ func GetAllUsers(c *gin.Context) {
 			return
 		}
 		users = append(users, user.ToResponse())
+		keys = append(keys, key)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -145,10 +182,14 @@ func GetAllUsers(c *gin.Context) {
 		return
 	}
 
+	users, nextCursor := paginate(page, users, keys, func(u models.UserResponse) uuid.UUID { return u.ID })
+
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Users fetched successfully",
-		"users":   users,
-		"count":   len(users),
+		"message":     "Users fetched successfully",
+		"users":       users,
+		"count":       len(users),
+		"limit":       page.Limit,
+		"next_cursor": nextCursor,
 	})
 }
 
@@ -185,6 +226,22 @@ func GetUserByID(c *gin.Context) {
 		return
 	}
 
+	// Evaluators cannot resolve applicants under blind review to their identity
+	blind, err := blindToEvaluator(c, ctx, user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch user",
+			"details": err.Error(),
+		})
+		return
+	}
+	if blind {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "User not found",
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"message": "User fetched successfully",
 		"user":    user.ToResponse(),
@@ -316,6 +373,22 @@ func GetUserByEmail(c *gin.Context) {
 		return
 	}
 
+	// Evaluators cannot resolve applicants under blind review to their identity
+	blind, err := blindToEvaluator(c, ctx, user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch user",
+			"details": err.Error(),
+		})
+		return
+	}
+	if blind {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "User not found",
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"message": "User fetched successfully",
 		"user":    user.ToResponse(),
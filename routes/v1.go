@@ -27,12 +27,32 @@ func SetupV1Routes(router *gin.Engine) {
 		applications := v1.Group("/applications")
 		applications.Use(middleware.JWTAuthMiddleware()) // All application routes require authentication
 		{
-			applications.GET("", middleware.AdminOrAboveMiddleware(), GetAllApplications) // GET /api/v1/applications (get all apps)
-			applications.POST("", CreateApplication)                                      // POST /api/v1/applications (create new app)
-			applications.GET("/me", GetMyApplications)                                    // GET /api/v1/applications/me (get user's apps)
-			applications.PATCH("/:id/save", SaveApplication)                              // PATCH /api/v1/applications/:id/save (save answers)
-			applications.POST("/:id/submit", SubmitApplication)                           // POST /api/v1/applications/:id/submit (submit app)
-			applications.DELETE("/:id", DeleteApplication)                                // DELETE /api/v1/applications/:id (delete app)
+			applications.GET("", middleware.AdminOrAboveMiddleware(), GetAllApplications)                               // GET /api/v1/applications (get all apps; ?tag=&round=&domain=)
+			applications.POST("", CreateApplication)                                                                    // POST /api/v1/applications (create new app)
+			applications.GET("/dossiers.zip", middleware.EvaluatorOrAboveMiddleware(), GetDepartmentDossiers)           // GET /api/v1/applications/dossiers.zip?department= (evaluator+)
+			applications.GET("/me", GetMyApplications)                                                                  // GET /api/v1/applications/me (get user's apps)
+			applications.PUT("/me/preferences", SetApplicationPreferences)                                              // PUT /api/v1/applications/me/preferences (rank own applications)
+			applications.GET("/me/results", GetMyResults)                                                               // GET /api/v1/applications/me/results (outcomes once published)
+			applications.GET("/me/tasks", GetMyTasks)                                                                   // GET /api/v1/applications/me/tasks (tasks of admitted rounds, with own deadline)
+			applications.PATCH("/:id/save", SaveApplication)                                                            // PATCH /api/v1/applications/:id/save (save answers)
+			applications.POST("/:id/submit", SubmitApplication)                                                         // POST /api/v1/applications/:id/submit (submit app)
+			applications.POST("/:id/withdraw", WithdrawApplication)                                                     // POST /api/v1/applications/:id/withdraw (optional reason)
+			applications.POST("/:id/reinstate", ReinstateApplication)                                                   // POST /api/v1/applications/:id/reinstate (within grace period)
+			applications.PUT("/:id/tasks/:task_id/submission", SubmitTask)                                              // PUT /api/v1/applications/:id/tasks/:task_id/submission (repository/deploy links)
+			applications.POST("/:id/offer/accept", AcceptOffer)                                                         // POST /api/v1/applications/:id/offer/accept (before the response deadline)
+			applications.POST("/:id/offer/decline", DeclineOffer)                                                       // POST /api/v1/applications/:id/offer/decline (before the response deadline)
+			applications.DELETE("/:id", DeleteApplication)                                                              // DELETE /api/v1/applications/:id (delete app)
+			applications.GET("/:id/evaluations", middleware.EvaluatorOrAboveMiddleware(), GetApplicationEvaluations)    // GET /api/v1/applications/:id/evaluations (evaluator+)
+			applications.POST("/:id/evaluations", middleware.EvaluatorOrAboveMiddleware(), RecordEvaluation)            // POST /api/v1/applications/:id/evaluations (evaluator+)
+			applications.GET("/:id/notes", middleware.EvaluatorOrAboveMiddleware(), GetApplicationNotes)                // GET /api/v1/applications/:id/notes (visible to the caller; evaluator+)
+			applications.POST("/:id/notes", middleware.EvaluatorOrAboveMiddleware(), CreateApplicationNote)             // POST /api/v1/applications/:id/notes (visibility: private|department|all)
+			applications.PUT("/:id/notes/:note_id", middleware.EvaluatorOrAboveMiddleware(), UpdateApplicationNote)     // PUT /api/v1/applications/:id/notes/:note_id (author only)
+			applications.DELETE("/:id/notes/:note_id", middleware.EvaluatorOrAboveMiddleware(), DeleteApplicationNote)  // DELETE /api/v1/applications/:id/notes/:note_id (author or admin)
+			applications.GET("/:id/tags", middleware.EvaluatorOrAboveMiddleware(), GetApplicationTags)                  // GET /api/v1/applications/:id/tags (evaluator+)
+			applications.POST("/:id/tags", middleware.EvaluatorOrAboveMiddleware(), AddApplicationTag)                  // POST /api/v1/applications/:id/tags (evaluator+)
+			applications.DELETE("/:id/tags/:tag", middleware.EvaluatorOrAboveMiddleware(), DeleteApplicationTag)        // DELETE /api/v1/applications/:id/tags/:tag (evaluator+)
+			applications.GET("/:id/dossier.pdf", middleware.EvaluatorOrAboveMiddleware(), GetApplicationDossier)        // GET /api/v1/applications/:id/dossier.pdf (evaluator+)
+			applications.GET("/:id/similarity", middleware.EvaluatorOrAboveMiddleware(), GetApplicationSimilarityFlags) // GET /api/v1/applications/:id/similarity (evaluator+)
 		}
 
 		// Answers routes (protected)
@@ -42,9 +62,17 @@ func SetupV1Routes(router *gin.Engine) {
 			answers.POST("", PostAnswer)                                                        // POST /api/v1/answers (create/update answer)
 			answers.DELETE("/:id", DeleteAnswer)                                                // DELETE /api/v1/answers/:id (delete answer)
 			answers.GET("/application/:id", GetUserAnswersForApplication)                       // GET /api/v1/answers/application/:id (get user's answers for app)
+			answers.GET("/:id/revisions", GetAnswerRevisions)                                   // GET /api/v1/answers/:id/revisions (owner or evaluator+)
 			answers.GET("/user/:id", middleware.EvaluatorOrAboveMiddleware(), GetAnswersByUser) // GET /api/v1/answers/user/:id (get all answers by user - evaluator+)
 		}
 
+		// Department routes (public)
+		departments := v1.Group("/departments")
+		departments.Use(middleware.DefaultRateLimiter())
+		{
+			departments.GET("", ListDepartments) // GET /api/v1/departments (active departments)
+		}
+
 		// Questions routes (public)
 		questions := v1.Group("/questions")
 		questions.Use(middleware.DefaultRateLimiter())
@@ -52,7 +80,11 @@ func SetupV1Routes(router *gin.Engine) {
 		{
 			questions.GET("", GetQuestions) // GET /api/v1/questions?dept=tech'
 			questions.POST("", middleware.AdminOrAboveMiddleware(), CreateQuestion)
-			questions.DELETE("/:id", middleware.AdminOrAboveMiddleware(), DeleteQuestion)
+			questions.PUT("/order", middleware.AdminOrAboveMiddleware(), ReorderQuestions)               // PUT /api/v1/questions/order
+			questions.PUT("/:id", middleware.AdminOrAboveMiddleware(), UpdateQuestion)                   // PUT /api/v1/questions/:id (new version when the wording changes)
+			questions.DELETE("/:id", middleware.AdminOrAboveMiddleware(), DeleteQuestion)                // DELETE /api/v1/questions/:id (archives answered questions)
+			questions.POST("/:id/restore", middleware.AdminOrAboveMiddleware(), RestoreQuestion)         // POST /api/v1/questions/:id/restore
+			questions.GET("/:id/versions", middleware.EvaluatorOrAboveMiddleware(), GetQuestionVersions) // GET /api/v1/questions/:id/versions (evaluator+)
 			questions.GET("/all", middleware.EvaluatorOrAboveMiddleware(), GetAllQuestions)
 			questions.GET("/:id", GetQuestionByID)
 		}
@@ -70,6 +102,98 @@ func SetupV1Routes(router *gin.Engine) {
 			users.DELETE("/:id", middleware.AdminOrAboveMiddleware(), DeleteUser) // DELETE /api/v1/users/:id (admin+)
 		}
 
+		// Task routes (evaluator+)
+		tasks := v1.Group("/tasks")
+		tasks.Use(middleware.JWTAuthMiddleware())
+		tasks.Use(middleware.EvaluatorOrAboveMiddleware())
+		{
+			tasks.GET("/:id/submissions", GetTaskSubmissions)                         // GET /api/v1/tasks/:id/submissions (with grades)
+			tasks.POST("/:id/submissions/:submission_id/grades", GradeTaskSubmission) // POST /api/v1/tasks/:id/submissions/:submission_id/grades (score 0-10)
+		}
+
+		// Search routes (evaluator+)
+		search := v1.Group("/search")
+		search.Use(middleware.DefaultRateLimiter())
+		search.Use(middleware.JWTAuthMiddleware())
+		search.Use(middleware.EvaluatorOrAboveMiddleware())
+		{
+			search.GET("/answers", SearchAnswers) // GET /api/v1/search/answers?q=kubernetes (&department=&limit=)
+		}
+
+		// Live event stream (protected, Server-Sent Events)
+		events := v1.Group("/events")
+		events.Use(middleware.QueryTokenMiddleware())
+		events.Use(middleware.JWTAuthMiddleware())
+		{
+			events.GET("/stream", StreamEvents) // GET /api/v1/events/stream (?token=<jwt> for EventSource clients)
+		}
+
+		// Notification routes (protected)
+		notifications := v1.Group("/notifications")
+		notifications.Use(middleware.JWTAuthMiddleware())
+		{
+			notifications.GET("", GetMyNotifications)                 // GET /api/v1/notifications (?unread=true)
+			notifications.POST("/read-all", MarkAllNotificationsRead) // POST /api/v1/notifications/read-all
+			notifications.POST("/:id/read", MarkNotificationRead)     // POST /api/v1/notifications/:id/read
+		}
+
+		// Webhook routes (admin+)
+		webhooks := v1.Group("/webhooks")
+		webhooks.Use(middleware.JWTAuthMiddleware())
+		webhooks.Use(middleware.AdminOrAboveMiddleware())
+		{
+			webhooks.GET("", GetAllWebhooks)                      // GET /api/v1/webhooks
+			webhooks.POST("", CreateWebhook)                      // POST /api/v1/webhooks
+			webhooks.GET("/:id", GetWebhookByID)                  // GET /api/v1/webhooks/:id
+			webhooks.PUT("/:id", UpdateWebhook)                   // PUT /api/v1/webhooks/:id
+			webhooks.DELETE("/:id", DeleteWebhook)                // DELETE /api/v1/webhooks/:id
+			webhooks.GET("/:id/deliveries", GetWebhookDeliveries) // GET /api/v1/webhooks/:id/deliveries
+			webhooks.POST("/:id/test", TestWebhook)               // POST /api/v1/webhooks/:id/test (send test event)
+		}
+
+		// Admin routes (admin+)
+		admin := v1.Group("/admin")
+		admin.Use(middleware.DefaultRateLimiter())
+		admin.Use(middleware.JWTAuthMiddleware())
+		admin.Use(middleware.AdminOrAboveMiddleware())
+		{
+			admin.GET("/stats", GetRecruitmentStats)                                     // GET /api/v1/admin/stats (?days=30)
+			admin.GET("/reports/calibration", GetScoreCalibration)                       // GET /api/v1/admin/reports/calibration (?department=&threshold=&format=json|csv&section=)
+			admin.GET("/export/applications", ExportApplications)                        // GET /api/v1/admin/export/applications (?format=csv|xlsx|ndjson&department=&status=)
+			admin.PUT("/applications/:id/status", SetApplicationDecision)                // PUT /api/v1/admin/applications/:id/status (selected|rejected|waitlisted|submitted)
+			admin.POST("/applications/:id/reveal", RevealApplicant)                      // POST /api/v1/admin/applications/:id/reveal (de-anonymise a blind-reviewed applicant; audit-logged)
+			admin.GET("/reveals", GetApplicantReveals)                                   // GET /api/v1/admin/reveals (reveal audit log; ?application_id=&limit=)
+			admin.POST("/allocation", AllocateApplications)                              // POST /api/v1/admin/allocation (resolve multi-department selections)
+			admin.POST("/departments/:slug/domains", CreateDepartmentDomain)             // POST /api/v1/admin/departments/:slug/domains
+			admin.PUT("/departments/:slug/domains/:domain", UpdateDepartmentDomain)      // PUT /api/v1/admin/departments/:slug/domains/:domain
+			admin.DELETE("/departments/:slug/domains/:domain", DeleteDepartmentDomain)   // DELETE /api/v1/admin/departments/:slug/domains/:domain
+			admin.POST("/questions/import", ImportQuestionBank)                          // POST /api/v1/admin/questions/import (YAML or JSON body; ?dry_run=true&prune=true)
+			admin.GET("/questions/export", ExportQuestionBank)                           // GET /api/v1/admin/questions/export (?format=yaml|json&department=)
+			admin.POST("/departments/:slug/shortlist", BuildShortlist)                   // POST /api/v1/admin/departments/:slug/shortlist (rank by score; dry_run to preview)
+			admin.GET("/departments/:slug/results", GetDepartmentResults)                // GET /api/v1/admin/departments/:slug/results
+			admin.PUT("/departments/:slug/results", StageResults)                        // PUT /api/v1/admin/departments/:slug/results (embargoed until publish_at; re-stage to refresh)
+			admin.DELETE("/departments/:slug/results", UnstageResults)                   // DELETE /api/v1/admin/departments/:slug/results (only before publication)
+			admin.GET("/departments/:slug/waitlist", GetWaitlist)                        // GET /api/v1/admin/departments/:slug/waitlist (ordered, with seat accounting)
+			admin.PUT("/departments/:slug/waitlist", ReorderWaitlist)                    // PUT /api/v1/admin/departments/:slug/waitlist (every waitlisted application, first in line first)
+			admin.POST("/departments/:slug/waitlist/promote", PromoteDepartmentWaitlist) // POST /api/v1/admin/departments/:slug/waitlist/promote (fill free seats now)
+			admin.PUT("/applications/:id/result/feedback", SetResultFeedback)            // PUT /api/v1/admin/applications/:id/result/feedback
+			admin.GET("/departments/:slug/rounds", GetDepartmentRounds)                  // GET /api/v1/admin/departments/:slug/rounds
+			admin.POST("/departments/:slug/rounds", CreateRound)                         // POST /api/v1/admin/departments/:slug/rounds (appended after the last round)
+			admin.PUT("/rounds/:id", UpdateRound)                                        // PUT /api/v1/admin/rounds/:id
+			admin.DELETE("/rounds/:id", DeleteRound)                                     // DELETE /api/v1/admin/rounds/:id (only rounds without questions or applicants)
+			admin.POST("/rounds/:id/advance", AdvanceApplications)                       // POST /api/v1/admin/rounds/:id/advance (bulk admit from the previous round)
+			admin.GET("/rounds/:id/tasks", GetRoundTasks)                                // GET /api/v1/admin/rounds/:id/tasks
+			admin.POST("/rounds/:id/tasks", CreateTask)                                  // POST /api/v1/admin/rounds/:id/tasks
+			admin.PUT("/tasks/:id", UpdateTask)                                          // PUT /api/v1/admin/tasks/:id
+			admin.DELETE("/tasks/:id", DeleteTask)                                       // DELETE /api/v1/admin/tasks/:id (only before any submission)
+			admin.PUT("/tasks/:id/extensions/:application_id", GrantTaskExtension)       // PUT /api/v1/admin/tasks/:id/extensions/:application_id
+			admin.DELETE("/tasks/:id/extensions/:application_id", RevokeTaskExtension)   // DELETE /api/v1/admin/tasks/:id/extensions/:application_id
+			admin.GET("/withdrawals", GetWithdrawalReport)                               // GET /api/v1/admin/withdrawals (?limit=)
+			admin.GET("/similarity", GetSimilarityFlags)                                 // GET /api/v1/admin/similarity (?min=0.8&department=&limit=)
+			admin.GET("/evaluators/:id/departments", GetEvaluatorDepartments)            // GET /api/v1/admin/evaluators/:id/departments
+			admin.PUT("/evaluators/:id/departments", SetEvaluatorDepartments)            // PUT /api/v1/admin/evaluators/:id/departments
+		}
+
 		// Super Admin routes (super admin only)
 		superAdmin := v1.Group("/admin")
 		superAdmin.Use(middleware.StrictRateLimiter())
@@ -77,8 +201,12 @@ func SetupV1Routes(router *gin.Engine) {
 		superAdmin.Use(middleware.SuperAdminOnlyMiddleware())
 		{
 			// Reserved for super admin specific routes
-			superAdmin.PUT("/users/:id/role", UpdateUserRole) // PUT /api/v1/super-admin/users/:id/role
-			superAdmin.PUT("/users/:id/verify", VerifyUser)   // PUT /api/v1/super-admin/users/:id/verify
+			superAdmin.PUT("/users/:id/role", UpdateUserRole)         // PUT /api/v1/super-admin/users/:id/role
+			superAdmin.PUT("/users/:id/verify", VerifyUser)           // PUT /api/v1/super-admin/users/:id/verify
+			superAdmin.GET("/departments", GetAllDepartments)         // GET /api/v1/admin/departments (including inactive)
+			superAdmin.POST("/departments", CreateDepartment)         // POST /api/v1/admin/departments
+			superAdmin.PUT("/departments/:slug", UpdateDepartment)    // PUT /api/v1/admin/departments/:slug (including blind_review)
+			superAdmin.DELETE("/departments/:slug", DeleteDepartment) // DELETE /api/v1/admin/departments/:slug
 		}
 	}
 }
@@ -0,0 +1,179 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetWaitlist handles GET /admin/departments/:slug/waitlist - shows a department's ordered waitlist and seats (admin+)
+func GetWaitlist(c *gin.Context) {
+	slug := c.Param("slug")
+
+	ctx := context.Background()
+	results, err := scanDepartmentResults(services.DB.QueryRow(ctx, queries.GetDepartmentResultsQuery, slug))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No results have been staged for this department"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch results", "details": err.Error()})
+		return
+	}
+
+	waitlist, err := fetchWaitlist(ctx, results)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch waitlist", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, waitlist)
+}
+
+// ReorderWaitlist handles PUT /admin/departments/:slug/waitlist - sets the order of a department's waitlist (admin+).
+// The request must list every waitlisted application exactly once.
+func ReorderWaitlist(c *gin.Context) {
+	slug := c.Param("slug")
+
+	var req models.ReorderWaitlistRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body", "details": err.Error()})
+		return
+	}
+
+	ctx := context.Background()
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to start transaction", "details": err.Error()})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the publication keeps promotions from changing the waitlist underneath the new order
+	results, err := scanDepartmentResults(tx.QueryRow(ctx, queries.GetDepartmentResultsQuery+" FOR UPDATE", slug))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No results have been staged for this department"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch results", "details": err.Error()})
+		return
+	}
+
+	rows, err := tx.Query(ctx, queries.GetWaitlistQuery, slug)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch waitlist", "details": err.Error()})
+		return
+	}
+	current, err := pgx.CollectRows(rows, scanWaitlistEntry)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to scan waitlist", "details": err.Error()})
+		return
+	}
+
+	waitlisted := map[uuid.UUID]bool{}
+	for _, entry := range current {
+		waitlisted[entry.ApplicationID] = true
+	}
+	seen := map[uuid.UUID]bool{}
+	for _, id := range req.ApplicationIDs {
+		if !waitlisted[id] || seen[id] {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Application is not on the waitlist or is listed twice", "application_id": id})
+			return
+		}
+		seen[id] = true
+	}
+	if len(seen) != len(waitlisted) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Every waitlisted application must be listed", "waitlisted": len(waitlisted)})
+		return
+	}
+
+	if _, err := tx.Exec(ctx, queries.ReorderWaitlistQuery, slug, req.ApplicationIDs, time.Now()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reorder waitlist", "details": err.Error()})
+		return
+	}
+	if err := tx.Commit(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to reorder waitlist", "details": err.Error()})
+		return
+	}
+
+	waitlist, err := fetchWaitlist(ctx, results)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch waitlist", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, waitlist)
+}
+
+// PromoteDepartmentWaitlist handles POST /admin/departments/:slug/waitlist/promote - offers a department's free seats
+// to its waitlist straight away (admin+), e.g. after raising its capacity. The results sweep does the same periodically.
+func PromoteDepartmentWaitlist(c *gin.Context) {
+	slug := c.Param("slug")
+
+	ctx := context.Background()
+	results, err := scanDepartmentResults(services.DB.QueryRow(ctx, queries.GetDepartmentResultsQuery, slug))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "No results have been staged for this department"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch results", "details": err.Error()})
+		return
+	}
+	if !results.Published {
+		c.JSON(http.StatusConflict, gin.H{"error": "Results for this department have not been published yet"})
+		return
+	}
+
+	promotions, err := services.PromoteWaitlist(ctx, slug)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to promote waitlist", "details": err.Error()})
+		return
+	}
+
+	waitlist, err := fetchWaitlist(ctx, results)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to fetch waitlist", "details": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Waitlist promoted successfully",
+		"promoted": len(promotions),
+		"waitlist": waitlist,
+	})
+}
+
+// fetchWaitlist loads a department's ordered waitlist with its seat accounting
+func fetchWaitlist(ctx context.Context, results models.DepartmentResults) (models.Waitlist, error) {
+	waitlist := models.Waitlist{Department: results.Department, Published: results.Published}
+
+	seats, err := services.FetchWaitlistSeats(ctx, results.Department)
+	if err != nil {
+		return waitlist, err
+	}
+	waitlist.Seats = seats
+
+	rows, err := services.DB.Query(ctx, queries.GetWaitlistQuery, results.Department)
+	if err != nil {
+		return waitlist, err
+	}
+	waitlist.Entries, err = pgx.CollectRows(rows, scanWaitlistEntry)
+	return waitlist, err
+}
+
+// scanWaitlistEntry scans a row selected by GetWaitlistQuery
+func scanWaitlistEntry(row pgx.CollectableRow) (models.WaitlistEntry, error) {
+	var entry models.WaitlistEntry
+	var err error
+	entry.ApplicationResult, err = scanApplicationResult(row, &entry.Status, &entry.FullName, &entry.Email)
+	return entry, err
+}
@@ -0,0 +1,359 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// validateWebhookEventTypes returns the first event type that cannot be subscribed to, if any
+func validateWebhookEventTypes(eventTypes []models.EventType) (models.EventType, bool) {
+	for _, eventType := range eventTypes {
+		if !slices.Contains(models.SubscribableEventTypes, eventType) {
+			return eventType, false
+		}
+	}
+	return "", true
+}
+
+// CreateWebhook handles POST /webhooks - creates a new webhook subscription
+func CreateWebhook(c *gin.Context) {
+	var req models.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if invalid, ok := validateWebhookEventTypes(req.EventTypes); !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid event type",
+			"details": map[string]any{
+				"event_type":       invalid,
+				"supported_events": models.SubscribableEventTypes,
+			},
+		})
+		return
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		generated, err := utils.GenerateWebhookSecret()
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to generate webhook secret",
+			})
+			return
+		}
+		secret = generated
+	}
+
+	var createdBy *uuid.UUID
+	if userID, exists := c.Get("userID"); exists {
+		if id, ok := userID.(uuid.UUID); ok {
+			createdBy = &id
+		}
+	}
+
+	ctx := context.Background()
+	var webhook models.Webhook
+	err := services.DB.QueryRow(ctx, queries.CreateWebhookQuery,
+		req.URL, secret, req.EventTypes, createdBy,
+	).Scan(
+		&webhook.ID, &webhook.URL, &webhook.Secret, &webhook.EventTypes, &webhook.Active,
+		&webhook.CreatedBy, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to create webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// The secret is only ever returned here, so the receiver can be configured with it
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Webhook created successfully",
+		"webhook": webhook,
+		"secret":  webhook.Secret,
+	})
+}
+
+// GetAllWebhooks handles GET /webhooks - fetches all webhook subscriptions
+func GetAllWebhooks(c *gin.Context) {
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetAllWebhooksQuery)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch webhooks",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	var webhooks []models.Webhook
+	for rows.Next() {
+		var webhook models.Webhook
+		err := rows.Scan(
+			&webhook.ID, &webhook.URL, &webhook.Secret, &webhook.EventTypes, &webhook.Active,
+			&webhook.CreatedBy, &webhook.CreatedAt, &webhook.UpdatedAt,
+		)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan webhook data",
+				"details": err.Error(),
+			})
+			return
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading webhooks",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Webhooks fetched successfully",
+		"webhooks": webhooks,
+		"count":    len(webhooks),
+	})
+}
+
+// GetWebhookByID handles GET /webhooks/:id - fetches a single webhook subscription
+func GetWebhookByID(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	var webhook models.Webhook
+	err = services.DB.QueryRow(ctx, queries.GetWebhookByIDQuery, webhookID).Scan(
+		&webhook.ID, &webhook.URL, &webhook.Secret, &webhook.EventTypes, &webhook.Active,
+		&webhook.CreatedBy, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhook fetched successfully",
+		"webhook": webhook,
+	})
+}
+
+// UpdateWebhook handles PUT /webhooks/:id - updates a webhook subscription
+func UpdateWebhook(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID format"})
+		return
+	}
+
+	var req models.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if invalid, ok := validateWebhookEventTypes(req.EventTypes); !ok {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid event type",
+			"details": map[string]any{
+				"event_type":       invalid,
+				"supported_events": models.SubscribableEventTypes,
+			},
+		})
+		return
+	}
+
+	ctx := context.Background()
+	var webhook models.Webhook
+	err = services.DB.QueryRow(ctx, queries.UpdateWebhookQuery,
+		webhookID, req.URL, req.EventTypes, *req.Active,
+	).Scan(
+		&webhook.ID, &webhook.URL, &webhook.Secret, &webhook.EventTypes, &webhook.Active,
+		&webhook.CreatedBy, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to update webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhook updated successfully",
+		"webhook": webhook,
+	})
+}
+
+// DeleteWebhook handles DELETE /webhooks/:id - deletes a webhook subscription and its delivery log
+func DeleteWebhook(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	result, err := services.DB.Exec(ctx, queries.DeleteWebhookQuery, webhookID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to delete webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if result.RowsAffected() == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Webhook deleted successfully"})
+}
+
+// GetWebhookDeliveries handles GET /webhooks/:id/deliveries - fetches the delivery log of a webhook
+func GetWebhookDeliveries(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID format"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 || limit > 500 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Limit must be between 1 and 500"})
+		return
+	}
+
+	ctx := context.Background()
+	rows, err := services.DB.Query(ctx, queries.GetWebhookDeliveriesQuery, webhookID, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch webhook deliveries",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	var deliveries []models.WebhookDelivery
+	for rows.Next() {
+		var delivery models.WebhookDelivery
+		err := rows.Scan(
+			&delivery.ID, &delivery.WebhookID, &delivery.EventID, &delivery.EventType, &delivery.Payload,
+			&delivery.Attempt, &delivery.Success, &delivery.StatusCode, &delivery.Error, &delivery.DurationMs,
+			&delivery.NextAttemptAt, &delivery.CreatedAt,
+		)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan webhook delivery data",
+				"details": err.Error(),
+			})
+			return
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading webhook deliveries",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Webhook deliveries fetched successfully",
+		"deliveries": deliveries,
+		"count":      len(deliveries),
+		"webhook_id": webhookID,
+	})
+}
+
+// TestWebhook handles POST /webhooks/:id/test - sends a signed webhook.test event and reports the outcome
+func TestWebhook(c *gin.Context) {
+	webhookID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid webhook ID format"})
+		return
+	}
+
+	ctx := context.Background()
+	var webhook models.Webhook
+	err = services.DB.QueryRow(ctx, queries.GetWebhookByIDQuery, webhookID).Scan(
+		&webhook.ID, &webhook.URL, &webhook.Secret, &webhook.EventTypes, &webhook.Active,
+		&webhook.CreatedBy, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Webhook not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch webhook",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	delivery, err := services.SendTestWebhook(c.Request.Context(), webhook)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to record test delivery",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if !delivery.Success {
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error":    "Test delivery failed",
+			"delivery": delivery,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Test delivery succeeded",
+		"delivery": delivery,
+	})
+}
@@ -0,0 +1,451 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/services"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gopkg.in/gomail.v2"
+)
+
+// Errors returned by the withdrawal helpers
+var (
+	errApplicationNotFound  = errors.New("application not found")
+	errAlreadyWithdrawn     = errors.New("application is already withdrawn")
+	errNotWithdrawn         = errors.New("application is not withdrawn")
+	errReinstatementExpired = errors.New("the reinstatement grace period has expired")
+)
+
+// chickenOutReason is recorded for applications withdrawn through POST /auth/chicken-out
+const chickenOutReason = "Chickened out"
+
+// WithdrawApplication handles POST /applications/:id/withdraw - withdraws one of the current user's applications
+func WithdrawApplication(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	// The reason is optional, so an empty body is accepted
+	var req models.WithdrawApplicationRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request body",
+				"details": err.Error(),
+			})
+			return
+		}
+	}
+
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	application, withdrawal, err := withdrawApplication(context.Background(), applicationID, userID, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, errApplicationNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found or access denied"})
+		case errors.Is(err, errAlreadyWithdrawn):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to withdraw application",
+				"details": err.Error(),
+			})
+		}
+		return
+	}
+
+	sendWithdrawalConfirmation(c.GetString("userEmail"), application, withdrawal)
+
+	// The status the application was withdrawn from may be an unpublished decision
+	if err := maskUnpublishedWithdrawal(context.Background(), &application, &withdrawal); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to check results publication",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "Application withdrawn successfully",
+		"application": application,
+		"withdrawal":  withdrawal,
+	})
+}
+
+// ReinstateApplication handles POST /applications/:id/reinstate - undoes a withdrawal within the grace period
+func ReinstateApplication(c *gin.Context) {
+	applicationID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid application ID"})
+		return
+	}
+
+	userIDInterface, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "User not authenticated"})
+		return
+	}
+	userID := userIDInterface.(uuid.UUID)
+
+	ctx := context.Background()
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to start transaction",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer tx.Rollback(ctx)
+
+	var application models.Application
+	err = tx.QueryRow(ctx, queries.LockUserApplicationQuery, applicationID, userID).Scan(
+		&application.ID, &application.UserID, &application.Department, &application.Submitted,
+		&application.Status, &application.Preference, &application.CreatedAt, &application.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Application not found or access denied"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch application",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	var withdrawal models.ApplicationWithdrawal
+	err = tx.QueryRow(ctx, queries.GetOpenWithdrawalQuery, applicationID).Scan(
+		&withdrawal.ID, &withdrawal.ApplicationID, &withdrawal.UserID, &withdrawal.Reason, &withdrawal.PreviousStatus,
+		&withdrawal.WithdrawnAt, &withdrawal.ReinstatableUntil, &withdrawal.ReinstatedAt,
+	)
+	if application.Status != models.ApplicationStatusWithdrawn || (err != nil && err.Error() == "no rows in result set") {
+		c.JSON(http.StatusConflict, gin.H{"error": errNotWithdrawn.Error()})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch withdrawal",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	now := time.Now()
+	if now.After(withdrawal.ReinstatableUntil) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":              errReinstatementExpired.Error(),
+			"reinstatable_until": withdrawal.ReinstatableUntil,
+		})
+		return
+	}
+
+	err = tx.QueryRow(ctx, queries.ReinstateWithdrawalQuery, withdrawal.ID, now).Scan(
+		&withdrawal.ID, &withdrawal.ApplicationID, &withdrawal.UserID, &withdrawal.Reason, &withdrawal.PreviousStatus,
+		&withdrawal.WithdrawnAt, &withdrawal.ReinstatableUntil, &withdrawal.ReinstatedAt,
+	)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to reinstate application",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	err = tx.QueryRow(ctx, queries.UpdateApplicationStatusQuery, applicationID, withdrawal.PreviousStatus, now).Scan(
+		&application.ID, &application.UserID, &application.Department, &application.Submitted,
+		&application.Status, &application.Preference, &application.CreatedAt, &application.UpdatedAt,
+	)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to reinstate application",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	// Coming back to any application undoes a chicken-out
+	if _, err := tx.Exec(ctx, queries.UpdateUserChickenedOutStatusQuery, userID, false); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to reinstate application",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to reinstate application",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	services.PublishEvent(models.EventApplicationStatusChanged, models.ApplicationStatusChange{
+		Application:    application,
+		PreviousStatus: models.ApplicationStatusWithdrawn,
+		Status:         application.Status,
+	})
+
+	// Reinstating restores the previous status, which may be an unpublished decision
+	if err := maskUnpublishedWithdrawal(ctx, &application, &withdrawal); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to check results publication",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "Application reinstated successfully",
+		"application": application,
+		"withdrawal":  withdrawal,
+	})
+}
+
+// GetWithdrawalReport handles GET /admin/withdrawals - summarises withdrawals by department and reason (?limit=)
+func GetWithdrawalReport(c *gin.Context) {
+	limit := defaultPageLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid limit. Must be between 1 and 200"})
+			return
+		}
+		limit = parsed
+	}
+
+	ctx := context.Background()
+	report := models.WithdrawalReport{GeneratedAt: time.Now().UTC()}
+
+	err := services.DB.QueryRow(ctx, queries.GetWithdrawalTotalsQuery).Scan(&report.Total, &report.Reinstated)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch withdrawal totals",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	if report.Departments, err = fetchWithdrawalCounts(ctx, queries.GetWithdrawalsByDepartmentQuery); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch withdrawals by department",
+			"details": err.Error(),
+		})
+		return
+	}
+	if report.Reasons, err = fetchWithdrawalCounts(ctx, queries.GetWithdrawalsByReasonQuery, limit); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch withdrawal reasons",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	rows, err := services.DB.Query(ctx, queries.GetRecentWithdrawalsQuery, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Failed to fetch recent withdrawals",
+			"details": err.Error(),
+		})
+		return
+	}
+	defer rows.Close()
+
+	report.Recent = []models.WithdrawalReportItem{}
+	for rows.Next() {
+		var item models.WithdrawalReportItem
+		err := rows.Scan(
+			&item.ID, &item.ApplicationID, &item.UserID, &item.Reason, &item.PreviousStatus,
+			&item.WithdrawnAt, &item.ReinstatableUntil, &item.ReinstatedAt,
+			&item.Department, &item.FullName, &item.RegNum,
+		)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":   "Failed to scan withdrawal",
+				"details": err.Error(),
+			})
+			return
+		}
+		report.Recent = append(report.Recent, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error occurred while reading withdrawals",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Withdrawal report fetched successfully",
+		"report":  report,
+	})
+}
+
+// withdrawApplication marks an application as withdrawn and opens a withdrawal that can be undone during the grace period
+func withdrawApplication(ctx context.Context, applicationID, userID uuid.UUID, reason string) (models.Application, models.ApplicationWithdrawal, error) {
+	var application models.Application
+	var withdrawal models.ApplicationWithdrawal
+
+	tx, err := services.DB.Begin(ctx)
+	if err != nil {
+		return application, withdrawal, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, queries.LockUserApplicationQuery, applicationID, userID).Scan(
+		&application.ID, &application.UserID, &application.Department, &application.Submitted,
+		&application.Status, &application.Preference, &application.CreatedAt, &application.UpdatedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return application, withdrawal, errApplicationNotFound
+		}
+		return application, withdrawal, err
+	}
+	if application.Status == models.ApplicationStatusWithdrawn {
+		return application, withdrawal, errAlreadyWithdrawn
+	}
+	previousStatus := application.Status
+
+	// Withdrawing gives up any offer, so its seat can go to the waitlist. Reinstating then brings the
+	// application back as released rather than selected, since the seat may be gone.
+	now := time.Now()
+	released, err := tx.Exec(ctx, queries.ReleaseOfferQuery, applicationID, now)
+	if err != nil {
+		return application, withdrawal, err
+	}
+	reinstateStatus := previousStatus
+	if released.RowsAffected() > 0 && previousStatus == models.ApplicationStatusSelected {
+		reinstateStatus = models.ApplicationStatusReleased
+	}
+
+	gracePeriod := utils.GetEnvAsDuration("WITHDRAWAL_GRACE_PERIOD", 72*time.Hour)
+	err = tx.QueryRow(ctx, queries.CreateWithdrawalQuery,
+		applicationID, userID, reason, reinstateStatus, now, now.Add(gracePeriod),
+	).Scan(
+		&withdrawal.ID, &withdrawal.ApplicationID, &withdrawal.UserID, &withdrawal.Reason, &withdrawal.PreviousStatus,
+		&withdrawal.WithdrawnAt, &withdrawal.ReinstatableUntil, &withdrawal.ReinstatedAt,
+	)
+	if err != nil {
+		return application, withdrawal, err
+	}
+
+	err = tx.QueryRow(ctx, queries.UpdateApplicationStatusQuery, applicationID, models.ApplicationStatusWithdrawn, now).Scan(
+		&application.ID, &application.UserID, &application.Department, &application.Submitted,
+		&application.Status, &application.Preference, &application.CreatedAt, &application.UpdatedAt,
+	)
+	if err != nil {
+		return application, withdrawal, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return application, withdrawal, err
+	}
+
+	// Withdrawn applications drop out of evaluator queues, which only list submitted applications
+	services.PublishEvent(models.EventApplicationStatusChanged, models.ApplicationStatusChange{
+		Application:    application,
+		PreviousStatus: previousStatus,
+		Status:         models.ApplicationStatusWithdrawn,
+	})
+
+	if released.RowsAffected() > 0 {
+		// Should this fail, the results sweep promotes on its next run
+		services.PromoteWaitlist(ctx, application.Department)
+	}
+
+	return application, withdrawal, nil
+}
+
+// withdrawAllApplications withdraws every active application of a user who chickened out
+func withdrawAllApplications(ctx context.Context, user models.User) ([]models.ApplicationWithdrawal, error) {
+	rows, err := services.DB.Query(ctx, queries.GetActiveUserApplicationIDsQuery, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	var applicationIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		applicationIDs = append(applicationIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	withdrawals := []models.ApplicationWithdrawal{}
+	for _, id := range applicationIDs {
+		application, withdrawal, err := withdrawApplication(ctx, id, user.ID, chickenOutReason)
+		if errors.Is(err, errAlreadyWithdrawn) {
+			continue
+		}
+		if err != nil {
+			return withdrawals, err
+		}
+		sendWithdrawalConfirmation(user.Email, application, withdrawal)
+		if err := maskUnpublishedWithdrawal(ctx, &application, &withdrawal); err != nil {
+			return withdrawals, err
+		}
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals, nil
+}
+
+// sendWithdrawalConfirmation queues the withdrawal confirmation email
+func sendWithdrawalConfirmation(email string, application models.Application, withdrawal models.ApplicationWithdrawal) {
+	if email == "" {
+		return
+	}
+
+	emailTemplate := utils.GetWithdrawalConfirmationTemplate(application.Department, withdrawal.ReinstatableUntil)
+	m := gomail.NewMessage()
+	m.SetHeader("From", utils.GetEnvWithDefault("EMAIL_FROM", "recruitments@no-reply.ieeecsvitc.com"))
+	m.SetHeader("To", email)
+	m.SetHeader("Subject", emailTemplate.Subject)
+	m.SetBody("text/html", emailTemplate.Body)
+
+	services.Mailer <- m
+}
+
+// fetchWithdrawalCounts runs a two-column (key, count) aggregate query
+func fetchWithdrawalCounts(ctx context.Context, query string, args ...any) ([]models.WithdrawalCount, error) {
+	rows, err := services.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := []models.WithdrawalCount{}
+	for rows.Next() {
+		var count models.WithdrawalCount
+		if err := rows.Scan(&count.Key, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	"bytes"
+	"math"
+	"slices"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/google/uuid"
+)
+
+// AllocateSelections resolves candidates selected by several departments.
+// It runs applicant-proposing deferred acceptance: each applicant is offered their most preferred
+// department first, and a department over capacity keeps its highest scoring candidates.
+// The result is stable: no applicant and department would both prefer each other over their outcome.
+// Capacities missing from the map are unlimited.
+func AllocateSelections(candidates []models.AllocationCandidate, capacities map[string]int) []models.AllocationResult {
+	// Each applicant's selected applications in preference order; unranked applications come last
+	choices := map[uuid.UUID][]int{}
+	var applicants []uuid.UUID
+	for i, c := range candidates {
+		if _, ok := choices[c.UserID]; !ok {
+			applicants = append(applicants, c.UserID)
+		}
+		choices[c.UserID] = append(choices[c.UserID], i)
+	}
+	for _, list := range choices {
+		slices.SortStableFunc(list, func(a, b int) int {
+			return preferenceRank(candidates[a].Preference) - preferenceRank(candidates[b].Preference)
+		})
+	}
+
+	held := map[string][]int{}
+	next := map[uuid.UUID]int{}
+	free := slices.Clone(applicants)
+	for len(free) > 0 {
+		applicant := free[0]
+		free = free[1:]
+
+		list := choices[applicant]
+		if next[applicant] >= len(list) {
+			continue
+		}
+		proposal := list[next[applicant]]
+		next[applicant]++
+
+		department := candidates[proposal].Department
+		held[department] = append(held[department], proposal)
+		capacity, limited := capacities[department]
+		if !limited || len(held[department]) <= capacity {
+			continue
+		}
+
+		// Over capacity: the department lets go of its lowest priority candidate
+		slices.SortFunc(held[department], func(a, b int) int {
+			return compareAllocationPriority(candidates[a], candidates[b])
+		})
+		rejected := held[department][len(held[department])-1]
+		held[department] = held[department][:len(held[department])-1]
+		free = append(free, candidates[rejected].UserID)
+	}
+
+	kept := map[int]bool{}
+	placed := map[uuid.UUID]bool{}
+	for _, list := range held {
+		for _, i := range list {
+			kept[i] = true
+			placed[candidates[i].UserID] = true
+		}
+	}
+
+	results := make([]models.AllocationResult, len(candidates))
+	for i, c := range candidates {
+		outcome := models.AllocationWaitlisted
+		switch {
+		case kept[i]:
+			outcome = models.AllocationKept
+		case placed[c.UserID]:
+			outcome = models.AllocationReleased
+		}
+		results[i] = models.AllocationResult{
+			ApplicationID: c.ApplicationID,
+			UserID:        c.UserID,
+			Department:    c.Department,
+			Preference:    c.Preference,
+			Score:         c.Score,
+			Outcome:       outcome,
+		}
+	}
+	return results
+}
+
+// preferenceRank orders ranked applications before unranked ones
+func preferenceRank(preference *int) int {
+	if preference == nil {
+		return math.MaxInt32
+	}
+	return *preference
+}
+
+// compareAllocationPriority orders candidates within a department: higher score first, then earlier submission, then ID
+func compareAllocationPriority(a, b models.AllocationCandidate) int {
+	if a.Score != b.Score {
+		if a.Score > b.Score {
+			return -1
+		}
+		return 1
+	}
+	if a.SubmittedAt != nil && b.SubmittedAt != nil && !a.SubmittedAt.Equal(*b.SubmittedAt) {
+		return a.SubmittedAt.Compare(*b.SubmittedAt)
+	}
+	return bytes.Compare(a.ApplicationID[:], b.ApplicationID[:])
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/google/uuid"
+)
+
+// testID returns a fixed UUID ending in n, so tests can refer to applications and applicants by number
+func testID(n byte) uuid.UUID {
+	var id uuid.UUID
+	id[15] = n
+	return id
+}
+
+func TestAllocateSelections(t *testing.T) {
+	submitted := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	type selection struct {
+		application byte
+		applicant   byte
+		department  string
+		preference  *int
+		score       float64
+		submittedAt *time.Time
+	}
+	tests := []struct {
+		name       string
+		selections []selection
+		capacities map[string]int
+		want       map[byte]string // Outcome by application
+	}{
+		{
+			name: "capacity overflow keeps the highest scores",
+			selections: []selection{
+				{1, 1, "technical", ptr(1), 9, nil},
+				{2, 2, "technical", ptr(1), 7, nil},
+				{3, 3, "technical", ptr(1), 8, nil},
+			},
+			capacities: map[string]int{"technical": 2},
+			want:       map[byte]string{1: models.AllocationKept, 2: models.AllocationWaitlisted, 3: models.AllocationKept},
+		},
+		{
+			name: "equal scores go to the earlier submission",
+			selections: []selection{
+				{1, 1, "technical", ptr(1), 8, ptr(submitted.Add(time.Hour))},
+				{2, 2, "technical", ptr(1), 8, ptr(submitted)},
+			},
+			capacities: map[string]int{"technical": 1},
+			want:       map[byte]string{1: models.AllocationWaitlisted, 2: models.AllocationKept},
+		},
+		{
+			name: "unranked choices come after ranked ones",
+			selections: []selection{
+				{1, 1, "technical", nil, 9, nil},
+				{2, 1, "design", ptr(1), 3, nil},
+			},
+			want: map[byte]string{1: models.AllocationReleased, 2: models.AllocationKept},
+		},
+		{
+			name: "unranked choices keep their order",
+			selections: []selection{
+				{1, 1, "technical", nil, 3, nil},
+				{2, 1, "design", nil, 9, nil},
+			},
+			want: map[byte]string{1: models.AllocationKept, 2: models.AllocationReleased},
+		},
+		{
+			// Applicant 2 loses technical to applicant 1 and moves on to design, where they outscore applicant 3
+			name: "rejection cascades to the second choice",
+			selections: []selection{
+				{1, 1, "technical", ptr(1), 9, nil},
+				{2, 2, "technical", ptr(1), 7, nil},
+				{3, 2, "design", ptr(2), 6, nil},
+				{4, 3, "design", ptr(1), 4, nil},
+			},
+			capacities: map[string]int{"technical": 1, "design": 1},
+			want: map[byte]string{
+				1: models.AllocationKept, 2: models.AllocationReleased, 3: models.AllocationKept, 4: models.AllocationWaitlisted,
+			},
+		},
+		{
+			name: "departments without a capacity are unlimited",
+			selections: []selection{
+				{1, 1, "design", ptr(1), 9, nil},
+				{2, 1, "technical", ptr(2), 9, nil},
+				{3, 2, "technical", ptr(1), 1, nil},
+			},
+			capacities: map[string]int{"design": 0},
+			want:       map[byte]string{1: models.AllocationReleased, 2: models.AllocationKept, 3: models.AllocationKept},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidates := make([]models.AllocationCandidate, len(tt.selections))
+			for i, s := range tt.selections {
+				candidates[i] = models.AllocationCandidate{
+					ApplicationID: testID(s.application),
+					UserID:        testID(100 + s.applicant),
+					Department:    s.department,
+					Preference:    s.preference,
+					Score:         s.score,
+					SubmittedAt:   s.submittedAt,
+				}
+			}
+
+			results := AllocateSelections(candidates, tt.capacities)
+			if len(results) != len(candidates) {
+				t.Fatalf("got %d results, want %d", len(results), len(candidates))
+			}
+			for i, result := range results {
+				application := tt.selections[i].application
+				if result.ApplicationID != testID(application) {
+					t.Errorf("result %d is for %s, want application %d", i, result.ApplicationID, application)
+				}
+				if result.Outcome != tt.want[application] {
+					t.Errorf("application %d outcome = %q, want %q", application, result.Outcome, tt.want[application])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"bytes"
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/google/uuid"
+)
+
+// CalibrateScores builds a calibration report from raw evaluations.
+// Each score is turned into a z-score against its evaluator's own mean and standard deviation, so harsh and
+// generous evaluators land on the same scale; an evaluator with no spread contributes z-scores of 0.
+// An evaluation is an outlier when its z-score is more than threshold away from the mean z-score of the
+// application's other evaluators.
+func CalibrateScores(scores []models.CalibrationScore, threshold float64) models.CalibrationReport {
+	report := models.CalibrationReport{
+		OutlierThreshold: threshold,
+		Evaluators:       []models.EvaluatorCalibration{},
+		Applications:     []models.NormalisedApplicationScore{},
+		Agreement:        models.InterRaterAgreement{Method: "krippendorff_alpha_interval"},
+		Outliers:         []models.OutlierEvaluation{},
+		GeneratedAt:      time.Now().UTC(),
+	}
+
+	// Per-evaluator mean and sample standard deviation
+	byEvaluator := map[uuid.UUID][]int{}
+	names := map[uuid.UUID]string{}
+	for i, s := range scores {
+		byEvaluator[s.EvaluatorID] = append(byEvaluator[s.EvaluatorID], i)
+		names[s.EvaluatorID] = s.EvaluatorName
+	}
+	stats := map[uuid.UUID]models.EvaluatorCalibration{}
+	for evaluatorID, indexes := range byEvaluator {
+		values := make([]float64, len(indexes))
+		for j, i := range indexes {
+			values[j] = float64(scores[i].Score)
+		}
+		mean, stddev := meanStdDev(values)
+		calibration := models.EvaluatorCalibration{
+			EvaluatorID:   evaluatorID,
+			EvaluatorName: names[evaluatorID],
+			Evaluations:   len(indexes),
+			Mean:          mean,
+			StdDev:        stddev,
+		}
+		stats[evaluatorID] = calibration
+		report.Evaluators = append(report.Evaluators, calibration)
+	}
+	slices.SortFunc(report.Evaluators, func(a, b models.EvaluatorCalibration) int {
+		return cmp.Or(cmp.Compare(a.EvaluatorName, b.EvaluatorName), bytes.Compare(a.EvaluatorID[:], b.EvaluatorID[:]))
+	})
+
+	z := make([]float64, len(scores))
+	for i, s := range scores {
+		if e := stats[s.EvaluatorID]; e.StdDev > 0 {
+			z[i] = (float64(s.Score) - e.Mean) / e.StdDev
+		}
+	}
+
+	// Per-application raw and normalised means
+	byApplication := map[uuid.UUID][]int{}
+	var applications []uuid.UUID
+	for i, s := range scores {
+		if _, ok := byApplication[s.ApplicationID]; !ok {
+			applications = append(applications, s.ApplicationID)
+		}
+		byApplication[s.ApplicationID] = append(byApplication[s.ApplicationID], i)
+	}
+	var units [][]float64
+	for _, applicationID := range applications {
+		indexes := byApplication[applicationID]
+		raw := make([]float64, len(indexes))
+		normalised := make([]float64, len(indexes))
+		for j, i := range indexes {
+			raw[j] = float64(scores[i].Score)
+			normalised[j] = z[i]
+		}
+		rawMean, _ := meanStdDev(raw)
+		normalisedMean, _ := meanStdDev(normalised)
+		report.Applications = append(report.Applications, models.NormalisedApplicationScore{
+			ApplicationID:   applicationID,
+			Department:      scores[indexes[0]].Department,
+			Evaluations:     len(indexes),
+			RawMean:         rawMean,
+			NormalisedScore: normalisedMean,
+		})
+
+		if len(indexes) < 2 {
+			continue
+		}
+		units = append(units, raw)
+		for j, i := range indexes {
+			others := 0.0
+			for k, o := range indexes {
+				if k != j {
+					others += z[o]
+				}
+			}
+			others /= float64(len(indexes) - 1)
+			if deviation := z[i] - others; math.Abs(deviation) > threshold {
+				report.Outliers = append(report.Outliers, models.OutlierEvaluation{
+					EvaluationID:  scores[i].EvaluationID,
+					ApplicationID: applicationID,
+					EvaluatorID:   scores[i].EvaluatorID,
+					EvaluatorName: scores[i].EvaluatorName,
+					Score:         scores[i].Score,
+					ZScore:        z[i],
+					OthersMeanZ:   others,
+					Deviation:     deviation,
+				})
+			}
+		}
+	}
+	slices.SortFunc(report.Applications, func(a, b models.NormalisedApplicationScore) int {
+		return cmp.Or(cmp.Compare(b.NormalisedScore, a.NormalisedScore), bytes.Compare(a.ApplicationID[:], b.ApplicationID[:]))
+	})
+	slices.SortFunc(report.Outliers, func(a, b models.OutlierEvaluation) int {
+		return cmp.Or(cmp.Compare(math.Abs(b.Deviation), math.Abs(a.Deviation)), bytes.Compare(a.EvaluationID[:], b.EvaluationID[:]))
+	})
+
+	report.Agreement.Alpha = krippendorffAlpha(units)
+	report.Agreement.Applications = len(units)
+	for _, unit := range units {
+		report.Agreement.Evaluations += len(unit)
+	}
+	return report
+}
+
+// meanStdDev returns the mean and sample standard deviation of values; the deviation is 0 for fewer than two values
+func meanStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if len(values) < 2 {
+		return mean, 0
+	}
+	squares := 0.0
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)-1))
+}
+
+// krippendorffAlpha computes Krippendorff's alpha with the interval metric over units that each hold two or more values.
+// It returns nil when there is nothing to compare or every value is the same.
+func krippendorffAlpha(units [][]float64) *float64 {
+	// Sum over ordered pairs i != j of (a_i - a_j)^2 equals 2(n*sum(a^2) - sum(a)^2)
+	pairwise := func(values []float64) (float64, float64, float64) {
+		var sum, squares float64
+		for _, v := range values {
+			sum += v
+			squares += v * v
+		}
+		return 2 * (float64(len(values))*squares - sum*sum), sum, squares
+	}
+
+	var n, observed, sum, squares float64
+	for _, unit := range units {
+		d, s, sq := pairwise(unit)
+		observed += d / float64(len(unit)-1)
+		n += float64(len(unit))
+		sum += s
+		squares += sq
+	}
+	if n < 2 {
+		return nil
+	}
+	observed /= n
+	expected := 2 * (n*squares - sum*sum) / (n * (n - 1))
+	if expected == 0 {
+		return nil
+	}
+	alpha := 1 - observed/expected
+	return &alpha
+}
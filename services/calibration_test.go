@@ -0,0 +1,223 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/google/uuid"
+)
+
+// approxEqual compares floats computed along different paths
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestKrippendorffAlpha(t *testing.T) {
+	tests := []struct {
+		name  string
+		units [][]float64
+		want  *float64
+	}{
+		// D_o = (2 + 2) / 4 = 1, D_e = 40 / 12 = 10/3
+		{name: "close agreement", units: [][]float64{{1, 2}, {3, 4}}, want: ptr(0.7)},
+		{name: "perfect agreement", units: [][]float64{{2, 2}, {5, 5}}, want: ptr(1.0)},
+		// D_o = (32 + 32) / 4 = 16, D_e = 128 / 12 = 32/3
+		{name: "systematic disagreement", units: [][]float64{{1, 5}, {5, 1}}, want: ptr(-0.5)},
+		// D_o = (12 / 2) / 3 = 2, D_e = 12 / 6 = 2
+		{name: "three raters on one unit", units: [][]float64{{1, 2, 3}}, want: ptr(0.0)},
+		// D_o = (84/2 + 32 + 2) / 7 = 76/7, D_e = 356 / 42 = 178/21
+		{name: "uneven units", units: [][]float64{{2, 6, 7}, {4, 8}, {6, 7}}, want: ptr(-25.0 / 89)},
+		{name: "zero variance", units: [][]float64{{3, 3}, {3, 3}}, want: nil},
+		{name: "no units", units: nil, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := krippendorffAlpha(tt.units)
+			switch {
+			case tt.want == nil && got != nil:
+				t.Errorf("alpha = %v, want nil", *got)
+			case tt.want != nil && got == nil:
+				t.Errorf("alpha = nil, want %v", *tt.want)
+			case tt.want != nil && !approxEqual(*got, *tt.want):
+				t.Errorf("alpha = %v, want %v", *got, *tt.want)
+			}
+		})
+	}
+}
+
+func TestCalibrateScores(t *testing.T) {
+	evaluatorA := uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+	evaluatorB := uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+	evaluatorC := uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+	evaluatorD := uuid.MustParse("00000000-0000-0000-0000-00000000000d")
+	names := map[uuid.UUID]string{evaluatorA: "A", evaluatorB: "B", evaluatorC: "C", evaluatorD: "D"}
+
+	appW := uuid.MustParse("00000000-0000-0000-0001-000000000000")
+	appX := uuid.MustParse("00000000-0000-0000-0002-000000000000")
+	appY := uuid.MustParse("00000000-0000-0000-0003-000000000000")
+	appZ := uuid.MustParse("00000000-0000-0000-0004-000000000000")
+
+	type evaluation struct {
+		id          int
+		application uuid.UUID
+		evaluator   uuid.UUID
+		score       int
+	}
+	evaluationID := func(id int) uuid.UUID {
+		var u uuid.UUID
+		u[15] = byte(id)
+		return u
+	}
+	scoresOf := func(evaluations []evaluation) []models.CalibrationScore {
+		var scores []models.CalibrationScore
+		for _, e := range evaluations {
+			scores = append(scores, models.CalibrationScore{
+				EvaluationID:  evaluationID(e.id),
+				ApplicationID: e.application,
+				Department:    "technical",
+				EvaluatorID:   e.evaluator,
+				EvaluatorName: names[e.evaluator],
+				Score:         e.score,
+			})
+		}
+		return scores
+	}
+
+	type evaluatorWant struct {
+		evaluator    uuid.UUID
+		mean, stddev float64
+	}
+	type applicationWant struct {
+		application         uuid.UUID
+		evaluations         int
+		raw, normalisedMean float64
+	}
+	tests := []struct {
+		name         string
+		evaluations  []evaluation
+		threshold    float64
+		evaluators   []evaluatorWant   // In report order: by name
+		applications []applicationWant // In report order: by normalised score, highest first
+		alpha        *float64
+		compared     int   // Applications with two or more evaluations
+		outliers     []int // Evaluation IDs in report order: largest deviation first
+	}{
+		{
+			// A scores 2, 4, 6 (mean 4, sd 2, z -1, 0, 1); B scores 6, 8 (mean 7, sd √2, z ∓1/√2);
+			// C always scores 7 and D scores once, so both have no spread and z-scores of 0
+			name: "mixed panel",
+			evaluations: []evaluation{
+				{1, appX, evaluatorA, 2}, {2, appY, evaluatorA, 4}, {3, appZ, evaluatorA, 6},
+				{4, appX, evaluatorB, 6}, {5, appY, evaluatorB, 8},
+				{6, appX, evaluatorC, 7}, {7, appZ, evaluatorC, 7},
+				{8, appW, evaluatorD, 3},
+			},
+			threshold: 0.9,
+			evaluators: []evaluatorWant{
+				{evaluatorA, 4, 2}, {evaluatorB, 7, math.Sqrt2}, {evaluatorC, 7, 0}, {evaluatorD, 3, 0},
+			},
+			applications: []applicationWant{
+				{appZ, 2, 6.5, 0.5},
+				{appY, 2, 6, 1 / (2 * math.Sqrt2)},
+				{appW, 1, 3, 0},
+				{appX, 3, 5, (-1 - 1/math.Sqrt2) / 3},
+			},
+			// Units X {2, 6, 7}, Y {4, 8} and Z {6, 7}; W has a single rater and is left out
+			alpha:    ptr(-25.0 / 89),
+			compared: 3,
+			// On Z, A's z-score of 1 is 1 away from C's 0 and the other way round; on X, C's 0 is only
+			// 0.85 away from the mean of -1 and -1/√2
+			outliers: []int{3, 7},
+		},
+		{
+			name: "single rater per application",
+			evaluations: []evaluation{
+				{1, appX, evaluatorA, 2}, {2, appY, evaluatorA, 6}, {3, appZ, evaluatorB, 9},
+			},
+			threshold: 0.5,
+			evaluators: []evaluatorWant{
+				{evaluatorA, 4, 2 * math.Sqrt2}, {evaluatorB, 9, 0},
+			},
+			applications: []applicationWant{
+				{appY, 1, 6, 1 / math.Sqrt2},
+				{appZ, 1, 9, 0},
+				{appX, 1, 2, -1 / math.Sqrt2},
+			},
+			alpha:    nil,
+			compared: 0,
+		},
+		{
+			name: "zero variance",
+			evaluations: []evaluation{
+				{1, appX, evaluatorA, 5}, {2, appX, evaluatorB, 5}, {3, appY, evaluatorA, 5}, {4, appY, evaluatorB, 5},
+			},
+			threshold: 0,
+			evaluators: []evaluatorWant{
+				{evaluatorA, 5, 0}, {evaluatorB, 5, 0},
+			},
+			applications: []applicationWant{
+				{appX, 2, 5, 0},
+				{appY, 2, 5, 0},
+			},
+			alpha:    nil,
+			compared: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := CalibrateScores(scoresOf(tt.evaluations), tt.threshold)
+
+			if len(report.Evaluators) != len(tt.evaluators) {
+				t.Fatalf("got %d evaluators, want %d", len(report.Evaluators), len(tt.evaluators))
+			}
+			for i, want := range tt.evaluators {
+				got := report.Evaluators[i]
+				if got.EvaluatorID != want.evaluator || !approxEqual(got.Mean, want.mean) || !approxEqual(got.StdDev, want.stddev) {
+					t.Errorf("evaluator %d = %s mean %v sd %v, want %s mean %v sd %v",
+						i, got.EvaluatorName, got.Mean, got.StdDev, names[want.evaluator], want.mean, want.stddev)
+				}
+			}
+
+			if len(report.Applications) != len(tt.applications) {
+				t.Fatalf("got %d applications, want %d", len(report.Applications), len(tt.applications))
+			}
+			for i, want := range tt.applications {
+				got := report.Applications[i]
+				if got.ApplicationID != want.application || got.Evaluations != want.evaluations ||
+					!approxEqual(got.RawMean, want.raw) || !approxEqual(got.NormalisedScore, want.normalisedMean) {
+					t.Errorf("application %d = %s (%d evaluations, raw %v, normalised %v), want %s (%d evaluations, raw %v, normalised %v)",
+						i, got.ApplicationID, got.Evaluations, got.RawMean, got.NormalisedScore,
+						want.application, want.evaluations, want.raw, want.normalisedMean)
+				}
+			}
+
+			switch got := report.Agreement.Alpha; {
+			case tt.alpha == nil && got != nil:
+				t.Errorf("alpha = %v, want nil", *got)
+			case tt.alpha != nil && got == nil:
+				t.Errorf("alpha = nil, want %v", *tt.alpha)
+			case tt.alpha != nil && !approxEqual(*got, *tt.alpha):
+				t.Errorf("alpha = %v, want %v", *got, *tt.alpha)
+			}
+			if report.Agreement.Applications != tt.compared {
+				t.Errorf("agreement over %d applications, want %d", report.Agreement.Applications, tt.compared)
+			}
+
+			if len(report.Outliers) != len(tt.outliers) {
+				t.Fatalf("got %d outliers, want %d", len(report.Outliers), len(tt.outliers))
+			}
+			for i, id := range tt.outliers {
+				if got := report.Outliers[i].EvaluationID; got != evaluationID(id) {
+					t.Errorf("outlier %d = %s, want %s", i, got, evaluationID(id))
+				}
+			}
+		})
+	}
+}
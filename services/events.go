@@ -0,0 +1,45 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/google/uuid"
+)
+
+// EventHandler is invoked for every published domain event.
+// Handlers run synchronously on the publishing goroutine and must not block.
+type EventHandler func(event models.Event)
+
+var (
+	eventHandlersMu sync.RWMutex
+	eventHandlers   []EventHandler
+)
+
+// SubscribeEvents registers a handler that receives every published domain event
+func SubscribeEvents(handler EventHandler) {
+	eventHandlersMu.Lock()
+	defer eventHandlersMu.Unlock()
+	eventHandlers = append(eventHandlers, handler)
+}
+
+// PublishEvent builds a domain event and fans it out to all subscribed handlers
+func PublishEvent(eventType models.EventType, data any) models.Event {
+	event := models.Event{
+		ID:         uuid.New(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}
+
+	eventHandlersMu.RLock()
+	handlers := eventHandlers
+	eventHandlersMu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+
+	return event
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// InitNotifications subscribes the notification center to domain events
+func InitNotifications(logger *zap.Logger) {
+	SubscribeEvents(func(event models.Event) {
+		// Notifications are written off the request path so publishing never blocks on the database
+		go func() {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Error("Notification handler panicked", zap.Any("panic", r))
+				}
+			}()
+
+			if err := notifyForEvent(context.Background(), event); err != nil {
+				logger.Error("Failed to create notification for event",
+					zap.Error(err),
+					zap.String("event_id", event.ID.String()),
+					zap.String("event_type", string(event.Type)))
+			}
+		}()
+	})
+
+	logger.Info("Notification center started")
+}
+
+// notifyForEvent creates the in-app notifications that correspond to a domain event
+func notifyForEvent(ctx context.Context, event models.Event) error {
+	switch event.Type {
+	case models.EventApplicationSubmitted:
+		app, ok := event.Data.(models.Application)
+		if !ok {
+			return fmt.Errorf("unexpected payload for %s", event.Type)
+		}
+		_, err := CreateNotification(ctx, app.UserID, models.NotificationSubmissionReceived,
+			"Application received",
+			fmt.Sprintf("We have received your application for the %s department.", app.Department),
+			map[string]any{"application_id": app.ID, "department": app.Department},
+		)
+		return err
+
+	case models.EventApplicationStatusChanged:
+		change, ok := event.Data.(models.ApplicationStatusChange)
+		if !ok {
+			return fmt.Errorf("unexpected payload for %s", event.Type)
+		}
+		// Submission already produces its own "received" notification
+		if change.Status == models.ApplicationStatusSubmitted {
+			return nil
+		}
+		// Decisions are announced with the results, so they are not notified before publication
+		if hidden, err := hiddenDecision(ctx, change); err != nil || hidden {
+			return err
+		}
+		previousStatus, err := ApplicantStatus(ctx, change.Application.Department, change.PreviousStatus)
+		if err != nil {
+			return err
+		}
+		_, err = CreateNotification(ctx, change.Application.UserID, models.NotificationStatusChanged,
+			"Application status updated",
+			fmt.Sprintf("Your application for the %s department is now %s.", change.Application.Department, change.Status),
+			map[string]any{
+				"application_id":  change.Application.ID,
+				"department":      change.Application.Department,
+				"previous_status": previousStatus,
+				"status":          change.Status,
+			},
+		)
+		return err
+
+	case models.EventResultsPublished:
+		publication, ok := event.Data.(models.ResultsPublication)
+		if !ok {
+			return fmt.Errorf("unexpected payload for %s", event.Type)
+		}
+		_, err := CreateNotification(ctx, publication.Application.UserID, models.NotificationResultsPublished,
+			"Results are out",
+			fmt.Sprintf("Results for the %s department have been published.", publication.Application.Department),
+			map[string]any{
+				"application_id": publication.Application.ID,
+				"department":     publication.Application.Department,
+			},
+		)
+		return err
+
+	case models.EventWaitlistPromoted:
+		promotion, ok := event.Data.(models.WaitlistPromotion)
+		if !ok {
+			return fmt.Errorf("unexpected payload for %s", event.Type)
+		}
+		_, err := CreateNotification(ctx, promotion.Application.UserID, models.NotificationWaitlistPromoted,
+			"You've been offered a place",
+			fmt.Sprintf("A place has opened up in the %s department and it is being offered to you from the waitlist.", promotion.Application.Department),
+			map[string]any{
+				"application_id":    promotion.Application.ID,
+				"department":        promotion.Application.Department,
+				"response_deadline": promotion.Result.ResponseDeadline,
+			},
+		)
+		return err
+
+	case models.EventApplicationAdvanced:
+		advance, ok := event.Data.(models.ApplicationAdvance)
+		if !ok {
+			return fmt.Errorf("unexpected payload for %s", event.Type)
+		}
+		_, err := CreateNotification(ctx, advance.Application.UserID, models.NotificationRoundAdvanced,
+			"You're through to the next round",
+			fmt.Sprintf("Your application for the %s department has moved on to %s.", advance.Application.Department, advance.Round.Name),
+			map[string]any{
+				"application_id": advance.Application.ID,
+				"department":     advance.Application.Department,
+				"round_id":       advance.Round.ID,
+				"round":          advance.Round.Name,
+			},
+		)
+		return err
+	}
+
+	return nil
+}
+
+// CreateNotification stores a new in-app notification for a user
+func CreateNotification(ctx context.Context, userID uuid.UUID, notificationType models.NotificationType, title, body string, data any) (models.Notification, error) {
+	var notification models.Notification
+
+	var encoded []byte
+	if data != nil {
+		var err error
+		if encoded, err = json.Marshal(data); err != nil {
+			return notification, fmt.Errorf("failed to marshal notification data: %w", err)
+		}
+	}
+
+	err := DB.QueryRow(ctx, queries.CreateNotificationQuery,
+		userID, notificationType, title, body, encoded,
+	).Scan(
+		&notification.ID, &notification.UserID, &notification.Type, &notification.Title,
+		&notification.Body, &notification.Data, &notification.ReadAt, &notification.CreatedAt,
+	)
+
+	return notification, err
+}
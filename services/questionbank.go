@@ -0,0 +1,808 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"gopkg.in/yaml.v3"
+)
+
+// questionBankEntryFields are the keys a question bank entry may use
+var questionBankEntryFields = []string{"id", "department", "domain", "type", "body", "options", "required", "order", "round", "conditions"}
+
+// yamlLinePattern extracts the line number from yaml.v3 error messages
+var yamlLinePattern = regexp.MustCompile(`line (\d+)`)
+
+// yamlParserProblems are the yaml.v3 syntax errors raised by its parser rather than its scanner. yaml.v3 reports
+// both at the line where the enclosing block or flow collection starts, but numbers it from zero for parser errors.
+var yamlParserProblems = []string{
+	"did not find expected key",
+	"did not find expected '-' indicator",
+	"did not find expected ',' or ']'",
+	"did not find expected ',' or '}'",
+	"did not find expected node content",
+}
+
+// QuestionBankError lists every problem found in a question bank
+type QuestionBankError struct {
+	Issues []models.QuestionBankIssue
+}
+
+func (e *QuestionBankError) Error() string {
+	lines := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		lines[i] = issue.String()
+	}
+	return "invalid question bank:\n" + strings.Join(lines, "\n")
+}
+
+// bankEntry is a parsed entry with the positions of its fields, for error reporting
+type bankEntry struct {
+	models.QuestionBankEntry
+	node   *yaml.Node
+	fields map[string]*yaml.Node
+
+	questionID uuid.UUID                  // The question the entry creates or updates, once matched
+	roundID    *uuid.UUID                 // The resolved round; nil creates in the first round or keeps the current one
+	conditions []models.QuestionCondition // The resolved conditions
+}
+
+// issueAt reports a problem at a field of the entry, falling back to the entry itself
+func (e *bankEntry) issueAt(field, message string) models.QuestionBankIssue {
+	node := e.node
+	if n, ok := e.fields[field]; ok {
+		node = n
+	}
+	return models.QuestionBankIssue{Line: node.Line, Column: node.Column, Field: field, Message: message}
+}
+
+// position returns where the entry goes within its department
+func (e *bankEntry) position(index int) int {
+	if e.Order > 0 {
+		return e.Order
+	}
+	return index
+}
+
+// parseQuestionBank decodes a YAML or JSON question bank (JSON is read as YAML, so both keep line numbers)
+// and checks everything that does not need the database
+func parseQuestionBank(data []byte) ([]*bankEntry, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		issue, ok := jsonSyntaxIssue(data)
+		if !ok {
+			issue = yamlIssue(err)
+		}
+		return nil, &QuestionBankError{Issues: []models.QuestionBankIssue{issue}}
+	}
+	if len(root.Content) == 0 {
+		return nil, &QuestionBankError{Issues: []models.QuestionBankIssue{{Line: 1, Column: 1, Message: "question bank is empty"}}}
+	}
+
+	doc := root.Content[0]
+	if doc.Kind != yaml.MappingNode {
+		return nil, &QuestionBankError{Issues: []models.QuestionBankIssue{{Line: doc.Line, Column: doc.Column, Message: "question bank must be a mapping with version and questions"}}}
+	}
+
+	var issues []models.QuestionBankIssue
+	var questions *yaml.Node
+	versionSeen := false
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		key, value := doc.Content[i], doc.Content[i+1]
+		switch key.Value {
+		case "version":
+			versionSeen = true
+			if version, err := strconv.Atoi(value.Value); err != nil || version != models.QuestionBankVersion {
+				issues = append(issues, models.QuestionBankIssue{Line: value.Line, Column: value.Column, Field: "version",
+					Message: fmt.Sprintf("unsupported version %q, expected %d", value.Value, models.QuestionBankVersion)})
+			}
+		case "questions":
+			questions = value
+		default:
+			issues = append(issues, models.QuestionBankIssue{Line: key.Line, Column: key.Column, Field: key.Value, Message: "unknown field"})
+		}
+	}
+	if !versionSeen {
+		issues = append(issues, models.QuestionBankIssue{Line: doc.Line, Column: doc.Column, Field: "version", Message: "is required"})
+	}
+	if questions == nil || questions.Kind != yaml.SequenceNode {
+		issues = append(issues, models.QuestionBankIssue{Line: doc.Line, Column: doc.Column, Field: "questions", Message: "must be a list"})
+		return nil, &QuestionBankError{Issues: issues}
+	}
+
+	entries := make([]*bankEntry, 0, len(questions.Content))
+	for _, item := range questions.Content {
+		if item.Kind != yaml.MappingNode {
+			issues = append(issues, models.QuestionBankIssue{Line: item.Line, Column: item.Column, Message: "question must be a mapping"})
+			continue
+		}
+
+		entry := &bankEntry{node: item, fields: map[string]*yaml.Node{}}
+		for i := 0; i+1 < len(item.Content); i += 2 {
+			key := item.Content[i]
+			if !slices.Contains(questionBankEntryFields, key.Value) {
+				issues = append(issues, models.QuestionBankIssue{Line: key.Line, Column: key.Column, Field: key.Value, Message: "unknown field"})
+				continue
+			}
+			entry.fields[key.Value] = item.Content[i+1]
+		}
+		if err := item.Decode(&entry.QuestionBankEntry); err != nil {
+			issues = append(issues, yamlIssue(err))
+			continue
+		}
+
+		if entry.Type == "" {
+			entry.Type = models.QuestionTypeLongText
+		}
+		entry.Body = strings.TrimSpace(entry.Body)
+		if entry.Department == "" {
+			issues = append(issues, entry.issueAt("department", "is required"))
+		}
+		if entry.Body == "" {
+			issues = append(issues, entry.issueAt("body", "is required"))
+		}
+		if err := models.ValidateQuestionShape(entry.Type, entry.Options); err != nil {
+			field := "type"
+			if slices.Contains(models.QuestionTypes, entry.Type) {
+				field = "options"
+			}
+			issues = append(issues, entry.issueAt(field, err.Error()))
+		}
+		if entry.Order < 0 {
+			issues = append(issues, entry.issueAt("order", "must be positive"))
+		}
+		entry.Round = strings.TrimSpace(entry.Round)
+		for _, condition := range entry.Conditions {
+			if strings.TrimSpace(condition.DependsOn) == "" {
+				issues = append(issues, entry.issueAt("conditions", "depends_on is required"))
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	// Cross-entry checks: IDs, wording and positions must be unique within a department
+	seenIDs := map[uuid.UUID]bool{}
+	seenBodies := map[string]bool{}
+	seenPositions := map[string]bool{}
+	indexes := map[string]int{}
+	for _, entry := range entries {
+		indexes[entry.Department]++
+		if entry.ID != nil {
+			if seenIDs[*entry.ID] {
+				issues = append(issues, entry.issueAt("id", "is listed more than once"))
+			}
+			seenIDs[*entry.ID] = true
+		}
+		bodyKey := entry.Department + "\x00" + entry.Body
+		if seenBodies[bodyKey] {
+			issues = append(issues, entry.issueAt("body", "duplicates another question of this department"))
+		}
+		seenBodies[bodyKey] = true
+		positionKey := fmt.Sprintf("%s\x00%d", entry.Department, entry.position(indexes[entry.Department]))
+		if seenPositions[positionKey] {
+			issues = append(issues, entry.issueAt("order", "clashes with another question of this department"))
+		}
+		seenPositions[positionKey] = true
+	}
+
+	if len(issues) > 0 {
+		slices.SortStableFunc(issues, func(a, b models.QuestionBankIssue) int { return a.Line - b.Line })
+		return nil, &QuestionBankError{Issues: issues}
+	}
+	return entries, nil
+}
+
+// ImportQuestionBank applies a question bank in a single transaction, or only reports the changes when dryRun is set.
+// Departments absent from the bank are untouched; with prune, live questions of the bank's departments
+// that the bank does not list are removed (archived when they have answers), unless a listed question depends on them.
+func ImportQuestionBank(ctx context.Context, data []byte, dryRun, prune bool) (models.QuestionBankImportResult, error) {
+	result := models.QuestionBankImportResult{DryRun: dryRun, Changes: []models.QuestionBankChange{}, Summary: map[string]int{
+		models.QuestionBankCreate: 0, models.QuestionBankUpdate: 0, models.QuestionBankUnchanged: 0, models.QuestionBankRemove: 0,
+	}}
+
+	entries, err := parseQuestionBank(data)
+	if err != nil {
+		return result, err
+	}
+
+	departments := []string{}
+	for _, entry := range entries {
+		if !slices.Contains(departments, entry.Department) {
+			departments = append(departments, entry.Department)
+		}
+	}
+
+	if err := validateBankReferences(ctx, entries); err != nil {
+		return result, err
+	}
+
+	existing, err := fetchLiveQuestions(ctx, departments)
+	if err != nil {
+		return result, err
+	}
+
+	// Match entries to existing questions by ID, then by exact wording
+	matched := map[uuid.UUID]*bankEntry{}
+	byID := map[uuid.UUID]models.Question{}
+	for _, q := range existing {
+		byID[q.ID] = q
+	}
+	var issues []models.QuestionBankIssue
+	for _, entry := range entries {
+		if entry.ID == nil {
+			continue
+		}
+		q, ok := byID[*entry.ID]
+		if !ok {
+			issues = append(issues, entry.issueAt("id", "no live question has this ID"))
+			continue
+		}
+		if q.Department != entry.Department {
+			issues = append(issues, entry.issueAt("department", fmt.Sprintf("question %s belongs to %s", q.ID, q.Department)))
+			continue
+		}
+		matched[q.ID] = entry
+	}
+	if len(issues) > 0 {
+		return result, &QuestionBankError{Issues: issues}
+	}
+	for _, entry := range entries {
+		if entry.ID != nil {
+			continue
+		}
+		for _, q := range existing {
+			if _, taken := matched[q.ID]; !taken && q.Department == entry.Department && q.Body == entry.Body {
+				id := q.ID
+				entry.ID = &id
+				matched[q.ID] = entry
+				break
+			}
+		}
+	}
+	for _, entry := range entries {
+		entry.questionID = uuid.New()
+		if entry.ID != nil {
+			entry.questionID = *entry.ID
+		}
+	}
+
+	if err := resolveBankRounds(ctx, entries, departments); err != nil {
+		return result, err
+	}
+	existingConditions, err := fetchBankConditions(ctx, existing)
+	if err != nil {
+		return result, err
+	}
+	if err := resolveBankConditions(entries, existing, existingConditions, matched, prune); err != nil {
+		return result, err
+	}
+
+	// Work out the change for every entry
+	type plannedChange struct {
+		change   models.QuestionBankChange
+		entry    *bankEntry
+		position int
+	}
+	var plan []plannedChange
+	indexes := map[string]int{}
+	for _, entry := range entries {
+		indexes[entry.Department]++
+		position := entry.position(indexes[entry.Department])
+		change := models.QuestionBankChange{
+			Department: entry.Department,
+			Body:       entry.Body,
+			Line:       entry.node.Line,
+		}
+		if entry.ID == nil {
+			change.Action = models.QuestionBankCreate
+		} else {
+			q := byID[*entry.ID]
+			change.QuestionID = entry.ID
+			change.Fields = questionBankDiff(q, existingConditions[q.ID], entry, position)
+			change.Action = models.QuestionBankUnchanged
+			if len(change.Fields) > 0 {
+				change.Action = models.QuestionBankUpdate
+			}
+		}
+		plan = append(plan, plannedChange{change: change, entry: entry, position: position})
+	}
+	if prune {
+		for _, q := range existing {
+			if _, ok := matched[q.ID]; ok {
+				continue
+			}
+			id := q.ID
+			plan = append(plan, plannedChange{change: models.QuestionBankChange{
+				Action: models.QuestionBankRemove, QuestionID: &id, Department: q.Department, Body: q.Body,
+			}})
+		}
+	}
+
+	for _, p := range plan {
+		result.Changes = append(result.Changes, p.change)
+		result.Summary[p.change.Action]++
+	}
+	if dryRun {
+		return result, nil
+	}
+
+	tx, err := DB.Begin(ctx)
+	if err != nil {
+		return result, err
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now()
+	for i, p := range plan {
+		entry := p.entry
+		switch p.change.Action {
+		case models.QuestionBankCreate:
+			var id uuid.UUID
+			err := tx.QueryRow(ctx, queries.CreateQuestionQuery,
+				entry.questionID, entry.Department, optionalString(entry.Domain), entry.Type, entry.Body, entry.Options, entry.Required, now, entry.roundID,
+			).Scan(&id, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			if err != nil {
+				return result, fmt.Errorf("line %d: failed to create question: %w", entry.node.Line, err)
+			}
+			result.Changes[i].QuestionID = &id
+			if _, err := tx.Exec(ctx, queries.SetQuestionPositionQuery, id, p.position); err != nil {
+				return result, fmt.Errorf("line %d: failed to position question: %w", entry.node.Line, err)
+			}
+		case models.QuestionBankUpdate:
+			_, err := tx.Exec(ctx, queries.UpdateQuestionQuery,
+				*entry.ID, entry.Body, optionalString(entry.Domain), entry.Type, entry.Options, entry.Required, now, entry.roundID,
+			)
+			if err != nil {
+				return result, fmt.Errorf("line %d: failed to update question: %w", entry.node.Line, err)
+			}
+			if _, err := tx.Exec(ctx, queries.SetQuestionPositionQuery, *entry.ID, p.position); err != nil {
+				return result, fmt.Errorf("line %d: failed to position question: %w", entry.node.Line, err)
+			}
+		}
+	}
+
+	// Conditions go in once every question exists, since they may point at questions created above
+	for _, p := range plan {
+		if p.change.Action != models.QuestionBankCreate && p.change.Action != models.QuestionBankUpdate {
+			continue
+		}
+		entry := p.entry
+		if _, err := tx.Exec(ctx, queries.DeleteQuestionConditionsQuery, entry.questionID); err != nil {
+			return result, fmt.Errorf("line %d: failed to replace conditions: %w", entry.node.Line, err)
+		}
+		for _, condition := range entry.conditions {
+			if _, err := tx.Exec(ctx, queries.InsertQuestionConditionQuery, entry.questionID, condition.DependsOn, condition.Operator, condition.Values, now); err != nil {
+				return result, fmt.Errorf("line %d: failed to add condition: %w", entry.node.Line, err)
+			}
+		}
+	}
+
+	for _, p := range plan {
+		switch p.change.Action {
+		case models.QuestionBankRemove:
+			deleted, err := tx.Exec(ctx, queries.DeleteQuestionByIDQuery, *p.change.QuestionID)
+			if err != nil {
+				return result, fmt.Errorf("failed to remove question %s: %w", p.change.QuestionID, err)
+			}
+			if deleted.RowsAffected() == 0 {
+				if _, err := tx.Exec(ctx, queries.ArchiveQuestionQuery, *p.change.QuestionID, now); err != nil {
+					return result, fmt.Errorf("failed to archive question %s: %w", p.change.QuestionID, err)
+				}
+			}
+		}
+	}
+
+	return result, tx.Commit(ctx)
+}
+
+// ExportQuestionBank returns the live questions of a department (or every department) as a bank.
+// Rounds are written by name, or by ID where the name is shared, and conditions refer to questions by ID.
+func ExportQuestionBank(ctx context.Context, department string) (models.QuestionBank, error) {
+	bank := models.QuestionBank{Version: models.QuestionBankVersion, Questions: []models.QuestionBankEntry{}}
+
+	departments := []string{}
+	if department != "" {
+		departments = append(departments, department)
+	}
+	questions, err := fetchLiveQuestions(ctx, departments)
+	if err != nil {
+		return bank, err
+	}
+	conditions, err := fetchBankConditions(ctx, questions)
+	if err != nil {
+		return bank, err
+	}
+
+	roundNames := map[uuid.UUID]string{}
+	for _, q := range questions {
+		if _, fetched := roundNames[q.RoundID]; fetched {
+			continue
+		}
+		rows, err := DB.Query(ctx, queries.GetDepartmentRoundsQuery, q.Department)
+		if err != nil {
+			return bank, err
+		}
+		rounds, err := pgx.CollectRows(rows, scanBankRound)
+		if err != nil {
+			return bank, err
+		}
+		for _, round := range rounds {
+			roundNames[round.ID] = round.Name
+			if slices.ContainsFunc(rounds, func(r models.Round) bool { return r.ID != round.ID && r.Name == round.Name }) {
+				roundNames[round.ID] = round.ID.String()
+			}
+		}
+	}
+
+	for _, q := range questions {
+		id := q.ID
+		entry := models.QuestionBankEntry{
+			ID:         &id,
+			Department: q.Department,
+			Type:       q.Type,
+			Body:       q.Body,
+			Options:    q.Options,
+			Required:   q.Required,
+			Order:      q.Position,
+			Round:      roundNames[q.RoundID],
+		}
+		if q.Domain != nil {
+			entry.Domain = *q.Domain
+		}
+		for _, condition := range conditions[q.ID] {
+			entry.Conditions = append(entry.Conditions, models.QuestionBankCondition{
+				DependsOn: condition.DependsOn.String(), Operator: condition.Operator, Values: condition.Values,
+			})
+		}
+		bank.Questions = append(bank.Questions, entry)
+	}
+	return bank, nil
+}
+
+// EncodeQuestionBank writes a bank as "yaml" or "json"
+func EncodeQuestionBank(bank models.QuestionBank, format string) ([]byte, error) {
+	switch format {
+	case "yaml":
+		return yaml.Marshal(bank)
+	case "json":
+		return json.MarshalIndent(bank, "", "  ")
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+// validateBankReferences checks that every department and sub-domain in the bank exists
+func validateBankReferences(ctx context.Context, entries []*bankEntry) error {
+	var issues []models.QuestionBankIssue
+	departments := map[string]bool{}
+	domains := map[string]bool{}
+	for _, entry := range entries {
+		exists, checked := departments[entry.Department]
+		if !checked {
+			if err := DB.QueryRow(ctx, queries.DepartmentExistsQuery, entry.Department).Scan(&exists, new(bool)); err != nil {
+				return err
+			}
+			departments[entry.Department] = exists
+		}
+		if !exists {
+			issues = append(issues, entry.issueAt("department", fmt.Sprintf("unknown department %q", entry.Department)))
+			continue
+		}
+
+		if entry.Domain == "" {
+			continue
+		}
+		key := entry.Department + "/" + entry.Domain
+		valid, checked := domains[key]
+		if !checked {
+			var count int
+			if err := DB.QueryRow(ctx, queries.CountDepartmentDomainsQuery, entry.Department, []string{entry.Domain}).Scan(&count); err != nil {
+				return err
+			}
+			valid = count == 1
+			domains[key] = valid
+		}
+		if !valid {
+			issues = append(issues, entry.issueAt("domain", fmt.Sprintf("%q is not a domain of %s", entry.Domain, entry.Department)))
+		}
+	}
+
+	if len(issues) > 0 {
+		return &QuestionBankError{Issues: issues}
+	}
+	return nil
+}
+
+// fetchLiveQuestions loads the live questions of the given departments (all when empty)
+func fetchLiveQuestions(ctx context.Context, departments []string) ([]models.Question, error) {
+	rows, err := DB.Query(ctx, queries.GetLiveQuestionsByDepartmentsQuery, departments)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Question, error) {
+		var q models.Question
+		err := row.Scan(
+			&q.ID, &q.Department, &q.Domain, &q.Type, &q.Body, &q.Options, &q.Required,
+			&q.RoundID, &q.Position, &q.Version, &q.ArchivedAt, &q.CreatedAt, &q.UpdatedAt,
+		)
+		return q, err
+	})
+}
+
+// resolveBankRounds sets the round of every entry that names one, by ID or by name within its department
+func resolveBankRounds(ctx context.Context, entries []*bankEntry, departments []string) error {
+	rounds := map[string][]models.Round{}
+	for _, department := range departments {
+		rows, err := DB.Query(ctx, queries.GetDepartmentRoundsQuery, department)
+		if err != nil {
+			return err
+		}
+		rounds[department], err = pgx.CollectRows(rows, scanBankRound)
+		if err != nil {
+			return err
+		}
+	}
+
+	var issues []models.QuestionBankIssue
+	for _, entry := range entries {
+		if entry.Round == "" {
+			continue
+		}
+		var found []models.Round
+		for _, round := range rounds[entry.Department] {
+			if round.ID.String() == entry.Round || round.Name == entry.Round {
+				found = append(found, round)
+			}
+		}
+		switch len(found) {
+		case 0:
+			issues = append(issues, entry.issueAt("round", fmt.Sprintf("%q is not a round of %s", entry.Round, entry.Department)))
+		case 1:
+			entry.roundID = &found[0].ID
+		default:
+			issues = append(issues, entry.issueAt("round", fmt.Sprintf("several rounds of %s are named %q; use the round's ID", entry.Department, entry.Round)))
+		}
+	}
+
+	if len(issues) > 0 {
+		return &QuestionBankError{Issues: issues}
+	}
+	return nil
+}
+
+// fetchBankConditions loads the visibility conditions of the given questions, keyed by question
+func fetchBankConditions(ctx context.Context, questions []models.Question) (map[uuid.UUID][]models.QuestionCondition, error) {
+	ids := make([]uuid.UUID, len(questions))
+	for i, q := range questions {
+		ids[i] = q.ID
+	}
+	rows, err := DB.Query(ctx, queries.GetQuestionConditionsQuery, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	conditions := map[uuid.UUID][]models.QuestionCondition{}
+	for rows.Next() {
+		var questionID uuid.UUID
+		var condition models.QuestionCondition
+		if err := rows.Scan(&questionID, &condition.DependsOn, &condition.Operator, &condition.Values); err != nil {
+			return nil, err
+		}
+		conditions[questionID] = append(conditions[questionID], condition)
+	}
+	return conditions, rows.Err()
+}
+
+// resolveBankConditions resolves every entry's conditions to the questions they depend on and checks them against
+// the questions as they will be after the import. A dependency must be a question of the same department that the
+// bank lists or, without prune, that stays live; the resulting conditions may not form a cycle.
+func resolveBankConditions(entries []*bankEntry, existing []models.Question, existingConditions map[uuid.UUID][]models.QuestionCondition,
+	matched map[uuid.UUID]*bankEntry, prune bool) error {
+	// The questions of the bank's departments after the import, with the conditions they will have
+	after := map[uuid.UUID]models.Question{}
+	dependsOn := map[uuid.UUID][]uuid.UUID{}
+	for _, q := range existing {
+		if _, listed := matched[q.ID]; !listed {
+			after[q.ID] = q
+			for _, condition := range existingConditions[q.ID] {
+				dependsOn[q.ID] = append(dependsOn[q.ID], condition.DependsOn)
+			}
+		}
+	}
+	for _, entry := range entries {
+		after[entry.questionID] = models.Question{
+			ID: entry.questionID, Department: entry.Department, Type: entry.Type, Body: entry.Body, Options: entry.Options,
+		}
+	}
+
+	var issues []models.QuestionBankIssue
+	for _, entry := range entries {
+		seen := map[uuid.UUID]bool{}
+		for _, c := range entry.Conditions {
+			reference := strings.TrimSpace(c.DependsOn)
+			dependency, found := models.Question{}, false
+			if id, err := uuid.Parse(reference); err == nil {
+				dependency, found = after[id]
+			} else {
+				// Listed entries come first, so a wording in the bank wins over an unlisted question's old wording
+				for _, other := range entries {
+					if other.Department == entry.Department && other.Body == reference {
+						dependency, found = after[other.questionID], true
+						break
+					}
+				}
+				for _, q := range after {
+					if !found && q.Department == entry.Department && q.Body == reference {
+						dependency, found = q, true
+					}
+				}
+			}
+
+			var problem string
+			switch {
+			case !found || dependency.Department != entry.Department:
+				problem = fmt.Sprintf("depends_on %q is not a question of %s", reference, entry.Department)
+			case dependency.ID == entry.questionID:
+				problem = "a question cannot depend on itself"
+			case seen[dependency.ID]:
+				problem = "only one condition per question is allowed"
+			case prune && matched[dependency.ID] == nil && !slices.ContainsFunc(entries, func(e *bankEntry) bool { return e.questionID == dependency.ID }):
+				problem = fmt.Sprintf("depends on question %s, which prune would remove", dependency.ID)
+			}
+			condition := models.QuestionCondition{DependsOn: dependency.ID, Operator: c.Operator, Values: c.Values}
+			if problem == "" {
+				if err := models.ValidateCondition(condition, dependency); err != nil {
+					problem = err.Error()
+				}
+			}
+			if problem != "" {
+				issues = append(issues, entry.issueAt("conditions", problem))
+				continue
+			}
+			seen[dependency.ID] = true
+			entry.conditions = append(entry.conditions, condition)
+			dependsOn[entry.questionID] = append(dependsOn[entry.questionID], dependency.ID)
+		}
+	}
+	if len(issues) > 0 {
+		return &QuestionBankError{Issues: issues}
+	}
+
+	// Walk each entry's dependencies; reaching the entry again means a cycle
+	for _, entry := range entries {
+		visited := map[uuid.UUID]bool{}
+		pending := slices.Clone(dependsOn[entry.questionID])
+		for len(pending) > 0 {
+			id := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+			if id == entry.questionID {
+				issues = append(issues, entry.issueAt("conditions", "conditions would form a cycle"))
+				break
+			}
+			if !visited[id] {
+				visited[id] = true
+				pending = append(pending, dependsOn[id]...)
+			}
+		}
+	}
+	if len(issues) > 0 {
+		return &QuestionBankError{Issues: issues}
+	}
+	return nil
+}
+
+// scanBankRound scans a row selected by GetDepartmentRoundsQuery
+func scanBankRound(row pgx.CollectableRow) (models.Round, error) {
+	var r models.Round
+	err := row.Scan(&r.ID, &r.Department, &r.Name, &r.Description, &r.Position, &r.CreatedAt, &r.UpdatedAt)
+	return r, err
+}
+
+// questionBankDiff lists the fields an entry would change on an existing question with the given conditions
+func questionBankDiff(q models.Question, conditions []models.QuestionCondition, entry *bankEntry, position int) []string {
+	var fields []string
+	if q.Body != entry.Body {
+		fields = append(fields, "body")
+	}
+	domain := ""
+	if q.Domain != nil {
+		domain = *q.Domain
+	}
+	if domain != entry.Domain {
+		fields = append(fields, "domain")
+	}
+	if q.Type != entry.Type {
+		fields = append(fields, "type")
+	}
+	if !slices.Equal(q.Options, entry.Options) && len(q.Options)+len(entry.Options) > 0 {
+		fields = append(fields, "options")
+	}
+	if q.Required != entry.Required {
+		fields = append(fields, "required")
+	}
+	if q.Position != position {
+		fields = append(fields, "order")
+	}
+	if entry.roundID != nil && *entry.roundID != q.RoundID {
+		fields = append(fields, "round")
+	}
+	if !sameConditions(conditions, entry.conditions) {
+		fields = append(fields, "conditions")
+	}
+	return fields
+}
+
+// sameConditions reports whether two sets of conditions are equal regardless of order
+func sameConditions(a, b []models.QuestionCondition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, x := range a {
+		if !slices.ContainsFunc(b, func(y models.QuestionCondition) bool {
+			return x.DependsOn == y.DependsOn && x.Operator == y.Operator && slices.Equal(x.Values, y.Values)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// yamlIssue converts a yaml.v3 error into an issue, recovering the line number from its message
+func yamlIssue(err error) models.QuestionBankIssue {
+	message := strings.TrimPrefix(err.Error(), "yaml: ")
+	if typeErr, ok := err.(*yaml.TypeError); ok && len(typeErr.Errors) > 0 {
+		message = typeErr.Errors[0]
+	}
+	issue := models.QuestionBankIssue{Message: message}
+	if m := yamlLinePattern.FindStringSubmatch(message); m != nil {
+		issue.Line, _ = strconv.Atoi(m[1])
+		issue.Message = strings.TrimSpace(strings.TrimPrefix(message, m[0]+":"))
+	}
+	if slices.Contains(yamlParserProblems, issue.Message) {
+		issue.Line++
+	}
+	return issue
+}
+
+// jsonSyntaxIssue places a syntax error in a JSON bank. yaml.v3 only reports where the broken collection starts,
+// so input that looks like JSON is parsed again with encoding/json, which reports the offending character.
+func jsonSyntaxIssue(data []byte) (models.QuestionBankIssue, bool) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') {
+		return models.QuestionBankIssue{}, false
+	}
+	var value any
+	var syntaxErr *json.SyntaxError
+	if err := json.Unmarshal(data, &value); !errors.As(err, &syntaxErr) || syntaxErr.Offset < 1 {
+		return models.QuestionBankIssue{}, false
+	}
+
+	read := data[:syntaxErr.Offset-1]
+	lineStart := bytes.LastIndexByte(read, '\n') + 1
+	return models.QuestionBankIssue{
+		Line:    bytes.Count(read, []byte("\n")) + 1,
+		Column:  utf8.RuneCount(read[lineStart:]) + 1,
+		Message: syntaxErr.Error(),
+	}, true
+}
+
+// optionalString maps an empty string to NULL
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+)
+
+func TestParseQuestionBank(t *testing.T) {
+	t.Run("valid bank", func(t *testing.T) {
+		entries, err := parseQuestionBank([]byte(`
+version: 1
+questions:
+  - department: technical
+    body: "  Why do you want to join?  "
+  - department: technical
+    type: single_choice
+    body: Pick a track
+    options: [Backend, Frontend]
+    order: 5
+`))
+		if err != nil {
+			t.Fatalf("parse: %v", err)
+		}
+		if len(entries) != 2 {
+			t.Fatalf("got %d entries, want 2", len(entries))
+		}
+		if entries[0].Type != models.QuestionTypeLongText || entries[0].Body != "Why do you want to join?" {
+			t.Errorf("first entry = %s %q, want a trimmed long_text question", entries[0].Type, entries[0].Body)
+		}
+		if entries[1].position(2) != 5 {
+			t.Errorf("second entry position = %d, want its order of 5", entries[1].position(2))
+		}
+	})
+
+	tests := []struct {
+		name   string
+		bank   string
+		issues []models.QuestionBankIssue // Message is only compared when set
+	}{
+		{
+			name: "yaml validation issues",
+			bank: `version: 1
+questions:
+  - department: technical
+    body: Why do you want to join?
+    colour: blue
+  - department: technical
+    type: single_choice
+    body: Pick a track
+    options: [Backend]
+  - department: design
+    body: "   "
+`,
+			issues: []models.QuestionBankIssue{
+				{Line: 5, Column: 5, Field: "colour", Message: "unknown field"},
+				{Line: 9, Column: 14, Field: "options", Message: "single_choice questions need at least two options"},
+				{Line: 11, Column: 11, Field: "body", Message: "is required"},
+			},
+		},
+		{
+			name: "yaml duplicates",
+			bank: `version: 1
+questions:
+  - department: technical
+    body: Why?
+  - department: technical
+    body: Why?
+    order: 1
+`,
+			issues: []models.QuestionBankIssue{
+				{Line: 6, Column: 11, Field: "body", Message: "duplicates another question of this department"},
+				{Line: 7, Column: 12, Field: "order", Message: "clashes with another question of this department"},
+			},
+		},
+		{
+			// YAML syntax errors only carry a line: where the broken list or mapping starts
+			name: "yaml misindented entry",
+			bank: `version: 1
+questions:
+  - department: technical
+   body: Why?
+`,
+			issues: []models.QuestionBankIssue{{Line: 3, Column: 0, Message: "did not find expected '-' indicator"}},
+		},
+		{
+			name: "yaml unclosed flow list",
+			bank: `version: 1
+questions:
+  - department: technical
+    options: [Backend, Frontend
+`,
+			issues: []models.QuestionBankIssue{{Line: 4, Column: 0, Message: "did not find expected ',' or ']'"}},
+		},
+		{
+			name: "yaml unterminated string",
+			bank: `version: 1
+questions:
+  - department: technical
+    body: "Why?
+`,
+			issues: []models.QuestionBankIssue{{Line: 4, Column: 0}},
+		},
+		{
+			name: "json validation issues",
+			bank: `{
+  "version": 2,
+  "questions": [
+    {"department": "technical", "body": "Why?"},
+    {"department": "", "body": "What?", "type": "essay"}
+  ]
+}`,
+			issues: []models.QuestionBankIssue{
+				{Line: 2, Column: 14, Field: "version", Message: `unsupported version "2", expected 1`},
+				{Line: 5, Column: 20, Field: "department", Message: "is required"},
+				{Line: 5, Column: 49, Field: "type"},
+			},
+		},
+		{
+			name: "json type error",
+			bank: `{
+  "version": 1,
+  "questions": [
+    {"department": "technical", "body": "Why?", "required": "maybe"}
+  ]
+}`,
+			issues: []models.QuestionBankIssue{{Line: 4, Column: 0}},
+		},
+		{
+			name: "json unclosed object",
+			bank: `{
+  "version": 1,
+  "questions": [
+    {"department": "technical", "body": "Why?"
+  ]
+}`,
+			issues: []models.QuestionBankIssue{{Line: 5, Column: 3, Message: "invalid character ']' after object key:value pair"}},
+		},
+		{
+			name: "json missing comma",
+			bank: `{
+  "version": 1,
+  "questions": [
+    {"department": "technical" "body": "Why?"}
+  ]
+}`,
+			issues: []models.QuestionBankIssue{{Line: 4, Column: 32, Message: "invalid character '\"' after object key:value pair"}},
+		},
+		{
+			name:   "json truncated",
+			bank:   `{"version": 1, "questions": [`,
+			issues: []models.QuestionBankIssue{{Line: 1, Column: 29, Message: "unexpected end of JSON input"}},
+		},
+		{
+			name:   "not a mapping",
+			bank:   `[1, 2]`,
+			issues: []models.QuestionBankIssue{{Line: 1, Column: 1, Message: "question bank must be a mapping with version and questions"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseQuestionBank([]byte(tt.bank))
+			var bankErr *QuestionBankError
+			if !errors.As(err, &bankErr) {
+				t.Fatalf("error = %v, want a QuestionBankError", err)
+			}
+			if len(bankErr.Issues) != len(tt.issues) {
+				t.Fatalf("got issues %v, want %v", bankErr.Issues, tt.issues)
+			}
+			for i, want := range tt.issues {
+				got := bankErr.Issues[i]
+				if got.Line != want.Line || got.Column != want.Column || got.Field != want.Field ||
+					(want.Message != "" && got.Message != want.Message) {
+					t.Errorf("issue %d = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// realtimeChannel is the Postgres NOTIFY channel shared by all backend replicas
+const realtimeChannel = "recruitment_events"
+
+// maxNotifyPayload keeps messages below Postgres' 8000 byte NOTIFY payload limit
+const maxNotifyPayload = 7900
+
+// StreamEvent is a domain event as delivered to live dashboard clients
+type StreamEvent struct {
+	ID         uuid.UUID        `json:"id"`
+	Type       models.EventType `json:"type"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       json.RawMessage  `json:"data,omitempty"`
+	Truncated  bool             `json:"truncated,omitempty"`
+	OwnerID    *uuid.UUID       `json:"owner_id,omitempty"` // Applicant the event concerns, used for filtering
+	Blind      bool             `json:"blind,omitempty"`    // The application the event concerns is under blind review
+	Department string           `json:"department,omitempty"`
+}
+
+// StreamClient is a single connected live-update subscriber
+type StreamClient struct {
+	UserID      uuid.UUID
+	Role        models.UserRole
+	Departments []string // Departments an evaluator is restricted to; empty means every department
+	Events      chan StreamEvent
+}
+
+// streamedEventTypes lists the domain events forwarded to live dashboard clients
+var streamedEventTypes = []models.EventType{
+	models.EventApplicationCreated,
+	models.EventApplicationSubmitted,
+	models.EventApplicationStatusChanged,
+	models.EventEvaluationRecorded,
+}
+
+var (
+	streamClientsMu sync.RWMutex
+	streamClients   = make(map[*StreamClient]struct{})
+)
+
+// InitRealtime forwards domain events through Postgres NOTIFY and starts listening for them,
+// so clients connected to any replica receive events raised on every replica
+func InitRealtime(logger *zap.Logger) {
+	SubscribeEvents(func(event models.Event) {
+		if !slices.Contains(streamedEventTypes, event.Type) {
+			return
+		}
+		go func() {
+			if err := notifyStreamEvent(context.Background(), event); err != nil {
+				logger.Error("Failed to notify stream event", zap.Error(err), zap.String("event_id", event.ID.String()))
+			}
+		}()
+	})
+
+	go listenStreamEvents(logger)
+
+	logger.Info("Realtime event stream started", zap.String("channel", realtimeChannel))
+}
+
+// SubscribeStream registers a live-update client; the returned function unregisters it.
+// An evaluator's department restrictions are read once here, so changes apply when they reconnect.
+func SubscribeStream(ctx context.Context, userID uuid.UUID, role models.UserRole) (*StreamClient, func(), error) {
+	client := &StreamClient{
+		UserID: userID,
+		Role:   role,
+		Events: make(chan StreamEvent, utils.GetEnvAsInt("SSE_CLIENT_BUFFER", 32)),
+	}
+	if scope := ReviewScope(userID, role); scope != nil {
+		departments, err := EvaluatorDepartments(ctx, *scope)
+		if err != nil {
+			return nil, nil, err
+		}
+		client.Departments = departments
+	}
+
+	streamClientsMu.Lock()
+	streamClients[client] = struct{}{}
+	streamClientsMu.Unlock()
+
+	return client, func() {
+		streamClientsMu.Lock()
+		delete(streamClients, client)
+		streamClientsMu.Unlock()
+	}, nil
+}
+
+// canReceiveStreamEvent applies role-based filtering to live events.
+// Admins see everything, evaluators see submitted work and evaluations
+// in the departments they review (anonymised under blind review), and
+// applicants only see events about their own applications.
+func canReceiveStreamEvent(client *StreamClient, event StreamEvent) bool {
+	switch client.Role {
+	case models.RoleAdmin, models.RoleSuperAdmin:
+		return true
+	case models.RoleEvaluator:
+		if len(client.Departments) > 0 && !slices.Contains(client.Departments, event.Department) {
+			return false
+		}
+		return event.Type != models.EventApplicationCreated
+	case models.RoleApplicant:
+		return event.Type != models.EventEvaluationRecorded && event.OwnerID != nil && *event.OwnerID == client.UserID
+	}
+	return false
+}
+
+// notifyStreamEvent publishes a domain event on the shared NOTIFY channel
+func notifyStreamEvent(ctx context.Context, event models.Event) error {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return err
+	}
+
+	message := StreamEvent{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		Data:       data,
+		OwnerID:    streamEventOwner(event),
+	}
+	if app := streamEventApplication(event); app != nil {
+		message.Department = app.Department
+		if err := DB.QueryRow(ctx, queries.ApplicationBlindReviewQuery, app.ID).Scan(&message.Blind); err != nil {
+			return err
+		}
+	}
+	if evaluation, ok := event.Data.(models.Evaluation); ok {
+		if err := DB.QueryRow(ctx, queries.GetApplicationDepartmentQuery, evaluation.ApplicationID).Scan(&message.Department); err != nil {
+			return err
+		}
+	}
+	if change, ok := event.Data.(models.ApplicationStatusChange); ok {
+		// Staff still see unpublished decisions live; the applicant does not, including a decision
+		// the change moves away from, such as withdrawing a selected application
+		hidden, err := hiddenDecision(ctx, change)
+		if err != nil {
+			return err
+		}
+		if !hidden {
+			hidden, err = hiddenDecision(ctx, models.ApplicationStatusChange{Application: change.Application, Status: change.PreviousStatus})
+			if err != nil {
+				return err
+			}
+		}
+		if hidden {
+			message.OwnerID = nil
+		}
+	}
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxNotifyPayload {
+		// Clients re-fetch the resource when the payload was too large to inline
+		message.Data = nil
+		message.Truncated = true
+		if payload, err = json.Marshal(message); err != nil {
+			return err
+		}
+	}
+
+	_, err = DB.Exec(ctx, "SELECT pg_notify($1, $2)", realtimeChannel, string(payload))
+	return err
+}
+
+// streamEventOwner returns the applicant a domain event concerns, if any
+func streamEventOwner(event models.Event) *uuid.UUID {
+	if app := streamEventApplication(event); app != nil {
+		return &app.UserID
+	}
+	return nil
+}
+
+// streamEventApplication returns the application a domain event carries, if any
+func streamEventApplication(event models.Event) *models.Application {
+	switch data := event.Data.(type) {
+	case models.Application:
+		return &data
+	case models.ApplicationStatusChange:
+		return &data.Application
+	case models.ResultsPublication:
+		return &data.Application
+	case models.OfferResponse:
+		return &data.Application
+	case models.WaitlistPromotion:
+		return &data.Application
+	}
+	return nil
+}
+
+// anonymiseStreamEvent strips the applicant's identity from an event about a blind-reviewed application.
+// Event payloads identify the applicant only by user ID, so every user_id field is removed.
+func anonymiseStreamEvent(event StreamEvent) StreamEvent {
+	event.OwnerID = nil
+	if len(event.Data) == 0 {
+		return event
+	}
+	var data any
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		event.Data, event.Truncated = nil, true
+		return event
+	}
+	stripUserIDs(data)
+	if anonymised, err := json.Marshal(data); err == nil {
+		event.Data = anonymised
+	} else {
+		event.Data, event.Truncated = nil, true
+	}
+	return event
+}
+
+// stripUserIDs removes every user_id field from a decoded JSON value
+func stripUserIDs(value any) {
+	switch v := value.(type) {
+	case map[string]any:
+		delete(v, "user_id")
+		for _, nested := range v {
+			stripUserIDs(nested)
+		}
+	case []any:
+		for _, nested := range v {
+			stripUserIDs(nested)
+		}
+	}
+}
+
+// listenStreamEvents holds a dedicated LISTEN connection and reconnects when it drops
+func listenStreamEvents(logger *zap.Logger) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("Realtime listener goroutine panicked", zap.Any("panic", r))
+		}
+	}()
+
+	backoff := time.Second
+	for {
+		err := listenStreamEventsOnce(context.Background(), logger)
+		logger.Error("Realtime listener disconnected, reconnecting", zap.Error(err), zap.Duration("backoff", backoff))
+		time.Sleep(backoff)
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+// listenStreamEventsOnce listens on the NOTIFY channel until the connection fails
+func listenStreamEventsOnce(ctx context.Context, logger *zap.Logger) error {
+	conn, err := DB.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+realtimeChannel); err != nil {
+		return err
+	}
+	logger.Info("Listening for realtime events", zap.String("channel", realtimeChannel))
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		var event StreamEvent
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			logger.Error("Failed to decode realtime event", zap.Error(err))
+			continue
+		}
+		broadcastStreamEvent(logger, event)
+	}
+}
+
+// broadcastStreamEvent delivers an event to every permitted local client without blocking on slow ones
+func broadcastStreamEvent(logger *zap.Logger, event StreamEvent) {
+	anonymised := event
+	if event.Blind {
+		anonymised = anonymiseStreamEvent(event)
+	}
+
+	streamClientsMu.RLock()
+	defer streamClientsMu.RUnlock()
+
+	for client := range streamClients {
+		if !canReceiveStreamEvent(client, event) {
+			continue
+		}
+		delivered := event
+		if client.Role == models.RoleEvaluator {
+			delivered = anonymised
+		}
+		select {
+		case client.Events <- delivered:
+		default:
+			logger.Warn("Dropping realtime event for slow client",
+				zap.String("user_id", client.UserID.String()),
+				zap.String("event_id", event.ID.String()))
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"gopkg.in/gomail.v2"
+)
+
+// InitResults starts the background job that announces results once their publication time arrives and keeps
+// departments' offers filled from their waitlists
+func InitResults(logger *zap.Logger) {
+	interval := utils.GetEnvAsDuration("RESULTS_SWEEP_INTERVAL", time.Minute)
+	if interval <= 0 {
+		logger.Info("Results sweep disabled")
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("Results sweep goroutine panicked", zap.Any("panic", r))
+			}
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			runResultsSweep(logger)
+			runWaitlistSweep(logger)
+			<-ticker.C
+		}
+	}()
+
+	logger.Info("Results sweep started", zap.Duration("interval", interval))
+}
+
+// runResultsSweep raises a results.published event for every result of each department whose publication came due,
+// and emails the applicants whose decision was withheld until then
+func runResultsSweep(logger *zap.Logger) {
+	ctx := context.Background()
+
+	rows, err := DB.Query(ctx, queries.AnnounceDueResultsQuery, time.Now())
+	if err != nil {
+		logger.Error("Failed to announce due results", zap.Error(err))
+		return
+	}
+	var departments []string
+	for rows.Next() {
+		var department string
+		if err := rows.Scan(&department); err != nil {
+			rows.Close()
+			logger.Error("Failed to scan announced department", zap.Error(err))
+			return
+		}
+		departments = append(departments, department)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		logger.Error("Failed to read announced departments", zap.Error(err))
+		return
+	}
+
+	for _, department := range departments {
+		publications, err := fetchResultsPublications(ctx, department)
+		if err != nil {
+			logger.Error("Failed to fetch published results", zap.Error(err), zap.String("department", department))
+			continue
+		}
+		for _, publication := range publications {
+			PublishEvent(models.EventResultsPublished, publication)
+		}
+		logger.Info("Results published", zap.String("department", department), zap.Int("results", len(publications)))
+
+		if err := sendResultsEmails(ctx, department); err != nil {
+			logger.Error("Failed to send withheld decision emails", zap.Error(err), zap.String("department", department))
+		}
+	}
+}
+
+// sendResultsEmails emails the applicants whose decision was withheld until their department's results were published
+func sendResultsEmails(ctx context.Context, department string) error {
+	rows, err := DB.Query(ctx, queries.ClaimResultsEmailsQuery, department)
+	if err != nil {
+		return err
+	}
+	emails, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return err
+	}
+
+	emailTemplate := utils.GetApplicationStatusTemplate(department)
+	messages := make([]*gomail.Message, 0, len(emails))
+	for _, email := range emails {
+		m := gomail.NewMessage()
+		m.SetHeader("From", utils.GetEnvWithDefault("EMAIL_FROM", "recruitments@no-reply.ieeecsvitc.com"))
+		m.SetHeader("To", email)
+		m.SetHeader("Subject", emailTemplate.Subject)
+		m.SetBody("text/html", emailTemplate.Body)
+		messages = append(messages, m)
+	}
+	// The mailer takes one message at a time, so the batch is handed over in the background
+	go func() {
+		for _, m := range messages {
+			Mailer <- m
+		}
+	}()
+	return nil
+}
+
+// fetchResultsPublications lists the results of a department together with their applications
+func fetchResultsPublications(ctx context.Context, department string) ([]models.ResultsPublication, error) {
+	rows, err := DB.Query(ctx, queries.GetPublishedResultApplicationsQuery, department)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var publications []models.ResultsPublication
+	for rows.Next() {
+		var p models.ResultsPublication
+		app, r := &p.Application, &p.Result
+		err := rows.Scan(
+			&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference, &app.CreatedAt, &app.UpdatedAt,
+			&r.ApplicationID, &r.Department, &r.Outcome, &r.Feedback, &r.OfferStatus, &r.ResponseDeadline, &r.RespondedAt,
+			&r.WaitlistPosition, &r.PromotedAt, &r.CreatedAt, &r.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		publications = append(publications, p)
+	}
+	return publications, rows.Err()
+}
+
+// ResultsPublished reports whether a department's results are published. Until they are, applicants
+// learn of no decision, whether or not results have been staged.
+func ResultsPublished(ctx context.Context, department string) (bool, error) {
+	var published bool
+	err := DB.QueryRow(ctx, queries.DepartmentResultsPublishedQuery, department).Scan(&published)
+	return published, err
+}
+
+// ApplicantStatus returns the status an applicant may see: decisions read as submitted until the department's
+// results are published
+func ApplicantStatus(ctx context.Context, department, status string) (string, error) {
+	if !slices.Contains(models.ResultOutcomes, status) {
+		return status, nil
+	}
+	published, err := ResultsPublished(ctx, department)
+	if err != nil || published {
+		return status, err
+	}
+	return models.ApplicationStatusSubmitted, nil
+}
+
+// hiddenDecision reports whether a status change is a decision the applicant may not learn of yet
+func hiddenDecision(ctx context.Context, change models.ApplicationStatusChange) (bool, error) {
+	status, err := ApplicantStatus(ctx, change.Application.Department, change.Status)
+	return status != change.Status, err
+}
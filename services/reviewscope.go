@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReviewScope returns the evaluator whose department restrictions apply to a reviewer: the reviewer
+// themselves when they are an evaluator, nil for admins, who review every department
+func ReviewScope(userID uuid.UUID, role models.UserRole) *uuid.UUID {
+	if role == models.RoleEvaluator {
+		return &userID
+	}
+	return nil
+}
+
+// EvaluatorDepartments returns the departments an evaluator is restricted to; empty means unrestricted
+func EvaluatorDepartments(ctx context.Context, evaluatorID uuid.UUID) ([]string, error) {
+	rows, err := DB.Query(ctx, queries.GetEvaluatorDepartmentsQuery, evaluatorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	departments := []string{}
+	for rows.Next() {
+		var department string
+		if err := rows.Scan(&department); err != nil {
+			return nil, err
+		}
+		departments = append(departments, department)
+	}
+	return departments, rows.Err()
+}
+
+// CanReviewDepartment reports whether a review scope covers a department
+func CanReviewDepartment(ctx context.Context, scope *uuid.UUID, department string) (bool, error) {
+	if scope == nil {
+		return true, nil
+	}
+	var allowed bool
+	err := DB.QueryRow(ctx, queries.EvaluatorReviewsDepartmentQuery, *scope, department).Scan(&allowed)
+	return allowed, err
+}
+
+// CanReviewApplication reports whether a review scope covers an application's department. An application that
+// does not exist is not covered by an evaluator's scope.
+func CanReviewApplication(ctx context.Context, scope *uuid.UUID, applicationID uuid.UUID) (bool, error) {
+	if scope == nil {
+		return true, nil
+	}
+	var allowed bool
+	err := DB.QueryRow(ctx, queries.EvaluatorReviewsApplicationQuery, *scope, applicationID).Scan(&allowed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return allowed, err
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"bytes"
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+)
+
+// scoreTolerance treats averaged scores this close together as equal
+const scoreTolerance = 1e-9
+
+// RankShortlist orders scored candidates and marks which of them make the shortlist.
+// Candidates are ranked by the chosen score, then by the tie-breakers in order and finally by application ID,
+// so the same input always produces the same ranking. The shortlist takes candidates from the top while they
+// meet minScore, up to capacity; nil leaves either limit off.
+func RankShortlist(candidates []models.ShortlistCandidate, score string, tieBreakers []string, capacity *int, minScore *float64) []models.ShortlistEntry {
+	primary := func(c models.ShortlistCandidate) float64 {
+		if score == models.ShortlistScoreNormalised {
+			return c.NormalisedScore
+		}
+		return c.RawScore
+	}
+	compareScores := func(a, b float64) int {
+		if math.Abs(a-b) <= scoreTolerance {
+			return 0
+		}
+		return cmp.Compare(b, a)
+	}
+
+	ranked := slices.Clone(candidates)
+	slices.SortFunc(ranked, func(a, b models.ShortlistCandidate) int {
+		if c := compareScores(primary(a), primary(b)); c != 0 {
+			return c
+		}
+		for _, tieBreaker := range tieBreakers {
+			var c int
+			switch tieBreaker {
+			case models.TieBreakRawScore:
+				c = compareScores(a.RawScore, b.RawScore)
+			case models.TieBreakEvaluations:
+				c = cmp.Compare(b.Evaluations, a.Evaluations)
+			case models.TieBreakPreference:
+				c = preferenceRank(a.Preference) - preferenceRank(b.Preference)
+			case models.TieBreakSubmittedAt:
+				c = compareSubmittedAt(a, b)
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return bytes.Compare(a.ApplicationID[:], b.ApplicationID[:])
+	})
+
+	entries := make([]models.ShortlistEntry, len(ranked))
+	shortlisted := 0
+	for i, c := range ranked {
+		entry := models.ShortlistEntry{
+			Rank:            i + 1,
+			ApplicationID:   c.ApplicationID,
+			ApplicantCode:   models.ApplicantCode(c.ApplicationID),
+			Status:          c.Status,
+			Score:           primary(c),
+			RawScore:        c.RawScore,
+			NormalisedScore: c.NormalisedScore,
+			Evaluations:     c.Evaluations,
+			Preference:      c.Preference,
+		}
+		if i > 0 && compareScores(primary(ranked[i-1]), entry.Score) == 0 {
+			entry.Tied = true
+			entries[i-1].Tied = true
+		}
+		if (capacity == nil || shortlisted < *capacity) && (minScore == nil || entry.Score >= *minScore-scoreTolerance) {
+			entry.Shortlisted = true
+			shortlisted++
+		}
+		entries[i] = entry
+	}
+	return entries
+}
+
+// compareSubmittedAt orders earlier submissions first; applications without a submission time come last
+func compareSubmittedAt(a, b models.ShortlistCandidate) int {
+	switch {
+	case a.SubmittedAt == nil && b.SubmittedAt == nil:
+		return 0
+	case a.SubmittedAt == nil:
+		return 1
+	case b.SubmittedAt == nil:
+		return -1
+	}
+	return a.SubmittedAt.Compare(*b.SubmittedAt)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+)
+
+func TestRankShortlist(t *testing.T) {
+	submitted := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	candidates := []models.ShortlistCandidate{
+		{ApplicationID: testID(1), RawScore: 8, NormalisedScore: 0.5, Evaluations: 3, Preference: ptr(1), SubmittedAt: ptr(submitted.Add(2 * time.Hour))},
+		{ApplicationID: testID(2), RawScore: 8, NormalisedScore: 0.2, Evaluations: 2},
+		{ApplicationID: testID(3), RawScore: 9, NormalisedScore: 0.1, Evaluations: 1, Preference: ptr(1), SubmittedAt: ptr(submitted)},
+		{ApplicationID: testID(4), RawScore: 8, NormalisedScore: 0.5, Evaluations: 3, Preference: ptr(2), SubmittedAt: ptr(submitted.Add(time.Hour))},
+		{ApplicationID: testID(5), RawScore: 5, NormalisedScore: -1, Evaluations: 2, Preference: ptr(1), SubmittedAt: ptr(submitted)},
+	}
+
+	tests := []struct {
+		name        string
+		score       string
+		tieBreakers []string
+		capacity    *int
+		minScore    *float64
+		order       []byte // Applications from first to last rank
+		shortlisted int    // The top entries that make the shortlist
+		tied        []byte
+	}{
+		{
+			// 1 and 4 share score and evaluations; 1 ranked the department higher
+			name:        "raw score with the default tie-breakers",
+			score:       models.ShortlistScoreRaw,
+			tieBreakers: models.DefaultShortlistTieBreakers,
+			capacity:    ptr(3),
+			order:       []byte{3, 1, 4, 2, 5},
+			shortlisted: 3,
+			tied:        []byte{1, 2, 4},
+		},
+		{
+			name:        "normalised score with a cutoff",
+			score:       models.ShortlistScoreNormalised,
+			tieBreakers: models.DefaultShortlistTieBreakers,
+			minScore:    ptr(0.15),
+			order:       []byte{1, 4, 2, 3, 5},
+			shortlisted: 3,
+			tied:        []byte{1, 4},
+		},
+		{
+			// Unsubmitted applications go last
+			name:        "submission time tie-breaker",
+			score:       models.ShortlistScoreRaw,
+			tieBreakers: []string{models.TieBreakSubmittedAt},
+			order:       []byte{3, 4, 1, 2, 5},
+			shortlisted: 5,
+			tied:        []byte{1, 2, 4},
+		},
+		{
+			name:        "ties without tie-breakers fall back to the application ID",
+			score:       models.ShortlistScoreRaw,
+			capacity:    ptr(0),
+			order:       []byte{3, 1, 2, 4, 5},
+			shortlisted: 0,
+			tied:        []byte{1, 2, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := RankShortlist(candidates, tt.score, tt.tieBreakers, tt.capacity, tt.minScore)
+			if len(entries) != len(tt.order) {
+				t.Fatalf("got %d entries, want %d", len(entries), len(tt.order))
+			}
+			for i, entry := range entries {
+				application := tt.order[i]
+				if entry.ApplicationID != testID(application) {
+					t.Errorf("rank %d is %s, want application %d", i+1, entry.ApplicationID, application)
+					continue
+				}
+				if entry.Rank != i+1 {
+					t.Errorf("application %d rank = %d, want %d", application, entry.Rank, i+1)
+				}
+				if want := i < tt.shortlisted; entry.Shortlisted != want {
+					t.Errorf("application %d shortlisted = %v, want %v", application, entry.Shortlisted, want)
+				}
+				if want := slices.Contains(tt.tied, application); entry.Tied != want {
+					t.Errorf("application %d tied = %v, want %v", application, entry.Tied, want)
+				}
+			}
+		})
+	}
+}
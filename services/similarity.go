@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MinHash parameters: 128 hashes in 32 bands of 4 rows make pairs above ~0.5 Jaccard very likely to become candidates
+const (
+	similarityShingleSize = 3
+	similarityHashes      = 128
+	similarityBandRows    = 4
+)
+
+// similarityScanLockKey is the advisory lock that keeps replicas from scanning at the same time
+const similarityScanLockKey = 7_340_034
+
+// similarityAnswer is an answer prepared for comparison
+type similarityAnswer struct {
+	id       uuid.UUID
+	userID   uuid.UUID
+	shingles utils.ShingleSet
+}
+
+// InitSimilarity starts the background job that flags near-identical answers
+func InitSimilarity(logger *zap.Logger) {
+	interval := utils.GetEnvAsDuration("SIMILARITY_SCAN_INTERVAL", 30*time.Minute)
+	if interval <= 0 {
+		logger.Info("Answer similarity scan disabled")
+		return
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("Similarity scan goroutine panicked", zap.Any("panic", r))
+			}
+		}()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			runSimilarityScan(logger)
+			<-ticker.C
+		}
+	}()
+
+	logger.Info("Answer similarity scan started", zap.Duration("interval", interval))
+}
+
+// runSimilarityScan compares the answers to every question once, holding the scan lock
+func runSimilarityScan(logger *zap.Logger) {
+	ctx := context.Background()
+
+	conn, err := DB.Acquire(ctx)
+	if err != nil {
+		logger.Error("Failed to acquire connection for similarity scan", zap.Error(err))
+		return
+	}
+	defer conn.Release()
+
+	var locked bool
+	if err := conn.QueryRow(ctx, queries.TryAdvisoryLockQuery, similarityScanLockKey).Scan(&locked); err != nil {
+		logger.Error("Failed to take similarity scan lock", zap.Error(err))
+		return
+	}
+	if !locked {
+		logger.Debug("Similarity scan already running elsewhere, skipping")
+		return
+	}
+	defer conn.Exec(ctx, queries.AdvisoryUnlockQuery, similarityScanLockKey)
+
+	threshold := float64(utils.GetEnvAsInt("SIMILARITY_THRESHOLD_PERCENT", 70)) / 100
+	minWords := utils.GetEnvAsInt("SIMILARITY_MIN_WORDS", 15)
+
+	rows, err := DB.Query(ctx, queries.GetSimilarityScanQuestionsQuery)
+	if err != nil {
+		logger.Error("Failed to list questions for similarity scan", zap.Error(err))
+		return
+	}
+	var questionIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			logger.Error("Failed to scan question for similarity scan", zap.Error(err))
+			return
+		}
+		questionIDs = append(questionIDs, id)
+	}
+	rows.Close()
+
+	started := time.Now()
+	hasher := utils.NewMinHasher(similarityHashes)
+	flagged := 0
+	for _, questionID := range questionIDs {
+		count, err := scanQuestionSimilarity(ctx, hasher, questionID, threshold, minWords)
+		if err != nil {
+			logger.Error("Similarity scan failed for question", zap.Error(err), zap.String("question_id", questionID.String()))
+			continue
+		}
+		flagged += count
+	}
+
+	logger.Info("Answer similarity scan finished",
+		zap.Int("questions", len(questionIDs)),
+		zap.Int("flagged_pairs", flagged),
+		zap.Duration("duration", time.Since(started)))
+}
+
+// scanQuestionSimilarity flags pairs of answers to one question whose Jaccard similarity reaches the threshold
+func scanQuestionSimilarity(ctx context.Context, hasher *utils.MinHasher, questionID uuid.UUID, threshold float64, minWords int) (int, error) {
+	checkedAt := time.Now()
+
+	rows, err := DB.Query(ctx, queries.GetSimilarityScanAnswersQuery, questionID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch answers: %w", err)
+	}
+	var answers []similarityAnswer
+	for rows.Next() {
+		var a similarityAnswer
+		var body string
+		if err := rows.Scan(&a.id, &a.userID, &body); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan answer: %w", err)
+		}
+		// Short answers ("yes", a single link) are naturally identical and would only add noise
+		if len(utils.Shingles(body, 1)) < minWords {
+			continue
+		}
+		a.shingles = utils.Shingles(body, similarityShingleSize)
+		answers = append(answers, a)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read answers: %w", err)
+	}
+
+	// Locality-sensitive hashing: only answers sharing a signature band are compared exactly
+	buckets := map[uint64][]int{}
+	for i, a := range answers {
+		for _, band := range utils.Bands(hasher.Signature(a.shingles), similarityBandRows) {
+			buckets[band] = append(buckets[band], i)
+		}
+	}
+
+	compared := map[[2]int]bool{}
+	flagged := 0
+	for _, bucket := range buckets {
+		for x := 0; x < len(bucket); x++ {
+			for y := x + 1; y < len(bucket); y++ {
+				pair := [2]int{bucket[x], bucket[y]}
+				if compared[pair] {
+					continue
+				}
+				compared[pair] = true
+
+				a, b := answers[pair[0]], answers[pair[1]]
+				if a.userID == b.userID {
+					continue
+				}
+				similarity := utils.Jaccard(a.shingles, b.shingles)
+				if similarity < threshold {
+					continue
+				}
+
+				first, second := a.id, b.id
+				if second.String() < first.String() {
+					first, second = second, first
+				}
+				if _, err := DB.Exec(ctx, queries.UpsertSimilarityFlagQuery, questionID, first, second, similarity, checkedAt); err != nil {
+					return flagged, fmt.Errorf("failed to store similarity flag: %w", err)
+				}
+				flagged++
+			}
+		}
+	}
+
+	if _, err := DB.Exec(ctx, queries.DeleteStaleSimilarityFlagsQuery, questionID, checkedAt); err != nil {
+		return flagged, fmt.Errorf("failed to remove stale similarity flags: %w", err)
+	}
+	return flagged, nil
+}
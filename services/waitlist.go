@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"gopkg.in/gomail.v2"
+)
+
+// FetchWaitlistSeats counts a department's seats against the offers that hold one
+func FetchWaitlistSeats(ctx context.Context, department string) (models.WaitlistSeats, error) {
+	return scanWaitlistSeats(DB.QueryRow(ctx, queries.GetWaitlistSeatsQuery, department))
+}
+
+// PromoteWaitlist offers every free seat of a department with published results to the front of its waitlist.
+// Promoted applicants are moved to selected with a pending offer, announced with a waitlist.promoted event and emailed.
+// Promoted offers lapse WAITLIST_RESPONSE_WINDOW after promotion, unless the department's offers stay open.
+func PromoteWaitlist(ctx context.Context, department string) ([]models.WaitlistPromotion, error) {
+	tx, err := DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the publication serialises promotions, so a seat is never offered twice
+	var responseDeadline *time.Time
+	if err := tx.QueryRow(ctx, queries.LockPublishedResultsQuery, department).Scan(&responseDeadline); err != nil {
+		if err.Error() == "no rows in result set" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	seats, err := scanWaitlistSeats(tx.QueryRow(ctx, queries.GetWaitlistSeatsQuery, department))
+	if err != nil {
+		return nil, err
+	}
+	if seats.Available == 0 || seats.Waitlisted == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	var deadline *time.Time
+	if responseDeadline != nil {
+		lapse := now.Add(utils.GetEnvAsDuration("WAITLIST_RESPONSE_WINDOW", 72*time.Hour))
+		deadline = &lapse
+	}
+
+	rows, err := tx.Query(ctx, queries.PromoteWaitlistQuery, department, seats.Available, deadline, now)
+	if err != nil {
+		return nil, err
+	}
+	var promotions []models.WaitlistPromotion
+	var emails []string
+	for rows.Next() {
+		var p models.WaitlistPromotion
+		var email string
+		r := &p.Result
+		err := rows.Scan(
+			&r.ApplicationID, &r.Department, &r.Outcome, &r.Feedback, &r.OfferStatus, &r.ResponseDeadline, &r.RespondedAt,
+			&r.WaitlistPosition, &r.PromotedAt, &r.CreatedAt, &r.UpdatedAt, &email,
+		)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		promotions = append(promotions, p)
+		emails = append(emails, email)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range promotions {
+		app := &promotions[i].Application
+		err := tx.QueryRow(ctx, queries.SetShortlistStatusQuery,
+			promotions[i].Result.ApplicationID, models.ApplicationStatusSelected, now, models.ApplicationStatusWaitlisted,
+		).Scan(&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference, &app.CreatedAt, &app.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := tx.Exec(ctx, queries.RenumberWaitlistQuery, department); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	messages := make([]*gomail.Message, 0, len(promotions))
+	for i, promotion := range promotions {
+		PublishEvent(models.EventWaitlistPromoted, promotion)
+
+		emailTemplate := utils.GetWaitlistPromotionTemplate(department, promotion.Result.ResponseDeadline)
+		m := gomail.NewMessage()
+		m.SetHeader("From", utils.GetEnvWithDefault("EMAIL_FROM", "recruitments@no-reply.ieeecsvitc.com"))
+		m.SetHeader("To", emails[i])
+		m.SetHeader("Subject", emailTemplate.Subject)
+		m.SetBody("text/html", emailTemplate.Body)
+		messages = append(messages, m)
+	}
+	// The mailer takes one message at a time, so the batch is handed over in the background
+	go func() {
+		for _, m := range messages {
+			Mailer <- m
+		}
+	}()
+
+	return promotions, nil
+}
+
+// runWaitlistSweep lapses overdue offers and refills the freed seats from each department's waitlist
+func runWaitlistSweep(logger *zap.Logger) {
+	ctx := context.Background()
+
+	rows, err := DB.Query(ctx, queries.ExpireOverdueOffersQuery, time.Now())
+	if err != nil {
+		logger.Error("Failed to expire overdue offers", zap.Error(err))
+		return
+	}
+	released, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Application, error) {
+		var app models.Application
+		err := row.Scan(&app.ID, &app.UserID, &app.Department, &app.Submitted, &app.Status, &app.Preference, &app.CreatedAt, &app.UpdatedAt)
+		return app, err
+	})
+	if err != nil {
+		logger.Error("Failed to expire overdue offers", zap.Error(err))
+		return
+	}
+	if len(released) > 0 {
+		logger.Info("Overdue offers expired", zap.Int("offers", len(released)))
+	}
+	for _, app := range released {
+		PublishEvent(models.EventApplicationStatusChanged, models.ApplicationStatusChange{
+			Application:    app,
+			PreviousStatus: models.ApplicationStatusSelected,
+			Status:         app.Status,
+		})
+	}
+
+	rows, err = DB.Query(ctx, queries.GetPromotableDepartmentsQuery)
+	if err != nil {
+		logger.Error("Failed to fetch departments with a waitlist", zap.Error(err))
+		return
+	}
+	departments, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		logger.Error("Failed to read departments with a waitlist", zap.Error(err))
+		return
+	}
+
+	for _, department := range departments {
+		promotions, err := PromoteWaitlist(ctx, department)
+		if err != nil {
+			logger.Error("Failed to promote waitlist", zap.Error(err), zap.String("department", department))
+			continue
+		}
+		if len(promotions) > 0 {
+			logger.Info("Waitlist promoted", zap.String("department", department), zap.Int("promoted", len(promotions)))
+		}
+	}
+}
+
+// scanWaitlistSeats scans a row selected by GetWaitlistSeatsQuery and works out the seats still available
+func scanWaitlistSeats(row pgx.Row) (models.WaitlistSeats, error) {
+	var s models.WaitlistSeats
+	if err := row.Scan(&s.Capacity, &s.Accepted, &s.Pending, &s.Waitlisted); err != nil {
+		return s, err
+	}
+	s.Available = max(s.Capacity-s.Accepted-s.Pending, 0)
+	return s, nil
+}
@@ -0,0 +1,341 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/ComputerSocietyVITC/recruitment-backend/models/queries"
+	"github.com/ComputerSocietyVITC/recruitment-backend/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// webhookJob is a pending delivery of an event payload to a single webhook
+type webhookJob struct {
+	webhook models.Webhook
+	event   models.Event
+	payload []byte
+	attempt int
+	retryOf *uuid.UUID // The logged attempt this job retries, whose lease is released when the job is recorded
+}
+
+var (
+	webhookEvents         chan models.Event
+	webhookJobs           chan webhookJob
+	webhookClient         = &http.Client{Timeout: 10 * time.Second}
+	webhookLogger         = zap.NewNop()
+	webhookMaxAttempts    = 5
+	webhookRetryBaseDelay = 30 * time.Second
+)
+
+// InitWebhooks subscribes the webhook dispatcher to domain events and starts its workers and retry sweep
+func InitWebhooks(logger *zap.Logger) {
+	webhookLogger = logger
+	webhookEvents = make(chan models.Event, utils.GetEnvAsInt("WEBHOOK_QUEUE_SIZE", 256))
+	webhookJobs = make(chan webhookJob, utils.GetEnvAsInt("WEBHOOK_QUEUE_SIZE", 256))
+	webhookClient = &http.Client{
+		Timeout: utils.GetEnvAsDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+	}
+	webhookMaxAttempts = utils.GetEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 5)
+	webhookRetryBaseDelay = utils.GetEnvAsDuration("WEBHOOK_RETRY_BASE_DELAY", 30*time.Second)
+
+	SubscribeEvents(func(event models.Event) {
+		select {
+		case webhookEvents <- event:
+		default:
+			logger.Error("Webhook event queue full, dropping event",
+				zap.String("event_id", event.ID.String()),
+				zap.String("event_type", string(event.Type)))
+		}
+	})
+
+	go runWebhookFanout(logger)
+
+	workers := utils.GetEnvAsInt("WEBHOOK_WORKERS", 2)
+	for i := 0; i < workers; i++ {
+		go runWebhookWorker(logger)
+	}
+
+	go runWebhookRetrySweep(logger, utils.GetEnvAsDuration("WEBHOOK_RETRY_SWEEP_INTERVAL", 15*time.Second))
+
+	logger.Info("Webhook dispatcher started", zap.Int("workers", workers))
+}
+
+// runWebhookFanout resolves the subscribers of each event and queues one job per webhook
+func runWebhookFanout(logger *zap.Logger) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("Webhook fanout goroutine panicked", zap.Any("panic", r))
+		}
+	}()
+
+	for event := range webhookEvents {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			logger.Error("Failed to marshal webhook event", zap.Error(err), zap.String("event_id", event.ID.String()))
+			continue
+		}
+
+		webhooks, err := getActiveWebhooksForEvent(context.Background(), event.Type)
+		if err != nil {
+			logger.Error("Failed to fetch webhooks for event", zap.Error(err), zap.String("event_type", string(event.Type)))
+			continue
+		}
+
+		for _, webhook := range webhooks {
+			enqueueWebhookJob(webhookJob{webhook: webhook, event: event, payload: payload, attempt: 1})
+		}
+	}
+}
+
+// runWebhookWorker delivers queued jobs. A failed attempt is logged with the time of its retry,
+// which the retry sweep picks up, so pending retries survive a restart.
+func runWebhookWorker(logger *zap.Logger) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("Webhook worker goroutine panicked", zap.Any("panic", r))
+		}
+	}()
+
+	for job := range webhookJobs {
+		delivery, err := deliverWebhook(context.Background(), job, true)
+		if err != nil {
+			logger.Error("Failed to record webhook delivery", zap.Error(err), zap.String("webhook_id", job.webhook.ID.String()))
+		}
+		if !delivery.Success && delivery.NextAttemptAt == nil {
+			logger.Warn("Webhook delivery failed permanently",
+				zap.String("webhook_id", job.webhook.ID.String()),
+				zap.String("event_id", job.event.ID.String()),
+				zap.Int("attempts", job.attempt))
+		}
+	}
+}
+
+// runWebhookRetrySweep periodically queues the failed deliveries whose retry has come due
+func runWebhookRetrySweep(logger *zap.Logger, interval time.Duration) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("Webhook retry sweep goroutine panicked", zap.Any("panic", r))
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		queued, err := queueDueWebhookRetries(context.Background())
+		if err != nil {
+			logger.Error("Failed to queue webhook retries", zap.Error(err))
+		} else if queued > 0 {
+			logger.Info("Webhook retries queued", zap.Int("deliveries", queued))
+		}
+		<-ticker.C
+	}
+}
+
+// queueDueWebhookRetries claims the retries that have come due, no more than the delivery queue has room for,
+// and queues their next attempt. A claim is only a lease for one delivery timeout: a retry that is dropped
+// from a full queue or lost in a restart comes due again, so retries are delivered at least once.
+// Retries to webhooks deactivated since are dropped.
+func queueDueWebhookRetries(ctx context.Context) (int, error) {
+	room := cap(webhookJobs) - len(webhookJobs)
+	if room <= 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	rows, err := DB.Query(ctx, queries.ClaimDueWebhookRetriesQuery, now, room, now.Add(webhookClient.Timeout))
+	if err != nil {
+		return 0, err
+	}
+	jobs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (webhookJob, error) {
+		var job webhookJob
+		var retryOf uuid.UUID
+		w := &job.webhook
+		err := row.Scan(
+			&retryOf, &job.event.ID, &job.event.Type, &job.payload, &job.attempt,
+			&w.ID, &w.URL, &w.Secret, &w.EventTypes, &w.Active, &w.CreatedBy, &w.CreatedAt, &w.UpdatedAt,
+		)
+		job.retryOf = &retryOf
+		job.attempt++
+		return job, err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, job := range jobs {
+		if job.webhook.Active && enqueueWebhookJob(job) {
+			queued++
+		}
+	}
+	return queued, nil
+}
+
+// webhookRetryAt returns when a failed attempt is retried, backing off 1x, 2x, 4x, ... the base delay,
+// or nil when it was the last attempt
+func webhookRetryAt(attempt int, failedAt time.Time) *time.Time {
+	if attempt >= webhookMaxAttempts {
+		return nil
+	}
+	next := failedAt.Add(webhookRetryBaseDelay * time.Duration(1<<(attempt-1)))
+	return &next
+}
+
+// enqueueWebhookJob queues a delivery without blocking the caller and reports whether it was queued
+func enqueueWebhookJob(job webhookJob) bool {
+	select {
+	case webhookJobs <- job:
+		return true
+	default:
+		webhookLogger.Error("Webhook delivery queue full, dropping delivery",
+			zap.String("webhook_id", job.webhook.ID.String()),
+			zap.String("event_id", job.event.ID.String()),
+			zap.Bool("retry", job.retryOf != nil))
+		return false
+	}
+}
+
+// SendTestWebhook synchronously delivers a webhook.test event to a webhook and returns the logged attempt
+func SendTestWebhook(ctx context.Context, webhook models.Webhook) (models.WebhookDelivery, error) {
+	event := models.Event{
+		ID:         uuid.New(),
+		Type:       models.EventWebhookTest,
+		OccurredAt: time.Now().UTC(),
+		Data: map[string]any{
+			"webhook_id": webhook.ID,
+			"message":    "This is a test delivery",
+		},
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return models.WebhookDelivery{}, fmt.Errorf("failed to marshal test event: %w", err)
+	}
+
+	return deliverWebhook(ctx, webhookJob{webhook: webhook, event: event, payload: payload, attempt: 1}, false)
+}
+
+// deliverWebhook performs a single signed HTTP delivery and records it in the delivery log,
+// scheduling a retry of a failed attempt when retry is set. Recording a retry releases the lease
+// on the attempt it retries in the same transaction.
+func deliverWebhook(ctx context.Context, job webhookJob, retry bool) (models.WebhookDelivery, error) {
+	delivery := attemptWebhook(ctx, job, retry)
+
+	tx, err := DB.Begin(ctx)
+	if err != nil {
+		return delivery, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, queries.CreateWebhookDeliveryQuery,
+		delivery.WebhookID, delivery.EventID, delivery.EventType, delivery.Payload, delivery.Attempt,
+		delivery.Success, delivery.StatusCode, delivery.Error, delivery.DurationMs, delivery.NextAttemptAt,
+	).Scan(
+		&delivery.ID, &delivery.WebhookID, &delivery.EventID, &delivery.EventType, &delivery.Payload,
+		&delivery.Attempt, &delivery.Success, &delivery.StatusCode, &delivery.Error, &delivery.DurationMs,
+		&delivery.NextAttemptAt, &delivery.CreatedAt,
+	)
+	if err != nil {
+		return delivery, err
+	}
+	if job.retryOf != nil {
+		if _, err := tx.Exec(ctx, queries.ReleaseWebhookRetryQuery, *job.retryOf); err != nil {
+			return delivery, err
+		}
+	}
+
+	return delivery, tx.Commit(ctx)
+}
+
+// attemptWebhook performs a single signed HTTP delivery and describes it for the delivery log
+func attemptWebhook(ctx context.Context, job webhookJob, retry bool) models.WebhookDelivery {
+	delivery := models.WebhookDelivery{
+		WebhookID: job.webhook.ID,
+		EventID:   job.event.ID,
+		EventType: job.event.Type,
+		Payload:   job.payload,
+		Attempt:   job.attempt,
+	}
+
+	start := time.Now()
+	statusCode, err := postWebhook(ctx, job)
+	delivery.DurationMs = int(time.Since(start).Milliseconds())
+
+	if statusCode != 0 {
+		delivery.StatusCode = &statusCode
+	}
+	if err != nil {
+		errMsg := err.Error()
+		delivery.Error = &errMsg
+		if retry {
+			delivery.NextAttemptAt = webhookRetryAt(job.attempt, time.Now())
+		}
+	} else {
+		delivery.Success = true
+	}
+
+	return delivery
+}
+
+// postWebhook sends the signed payload and treats any non-2xx response as a failure
+func postWebhook(ctx context.Context, job webhookJob) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.webhook.URL, bytes.NewReader(job.payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "recruitment-backend-webhooks/1.0")
+	req.Header.Set("X-Webhook-ID", job.webhook.ID.String())
+	req.Header.Set("X-Webhook-Event", string(job.event.Type))
+	req.Header.Set("X-Webhook-Event-ID", job.event.ID.String())
+	req.Header.Set("X-Webhook-Attempt", strconv.Itoa(job.attempt))
+	req.Header.Set("X-Webhook-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Webhook-Signature", utils.SignWebhookPayload(job.webhook.Secret, timestamp, job.payload))
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// getActiveWebhooksForEvent returns the active webhooks subscribed to an event type
+func getActiveWebhooksForEvent(ctx context.Context, eventType models.EventType) ([]models.Webhook, error) {
+	rows, err := DB.Query(ctx, queries.GetActiveWebhooksForEventQuery, string(eventType))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []models.Webhook
+	for rows.Next() {
+		var webhook models.Webhook
+		if err := rows.Scan(
+			&webhook.ID, &webhook.URL, &webhook.Secret, &webhook.EventTypes, &webhook.Active,
+			&webhook.CreatedBy, &webhook.CreatedAt, &webhook.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, rows.Err()
+}
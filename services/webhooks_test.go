@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ComputerSocietyVITC/recruitment-backend/models"
+	"github.com/google/uuid"
+)
+
+// webhookReceiver is a test endpoint that verifies signatures and fails its first requests
+type webhookReceiver struct {
+	t        *testing.T
+	secret   string
+	failures int
+
+	mu       sync.Mutex
+	attempts []string
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		r.t.Errorf("reading body: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(r.secret))
+	mac.Write([]byte(req.Header.Get("X-Webhook-Timestamp") + "."))
+	mac.Write(body)
+	if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); req.Header.Get("X-Webhook-Signature") != want {
+		r.t.Errorf("signature = %q, want %q", req.Header.Get("X-Webhook-Signature"), want)
+	}
+
+	r.mu.Lock()
+	r.attempts = append(r.attempts, req.Header.Get("X-Webhook-Attempt"))
+	failing := len(r.attempts) <= r.failures
+	r.mu.Unlock()
+
+	if failing {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// seen returns the X-Webhook-Attempt header of every request received so far
+func (r *webhookReceiver) seen() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string{}, r.attempts...)
+}
+
+func TestAttemptWebhook(t *testing.T) {
+	maxAttempts, baseDelay := webhookMaxAttempts, webhookRetryBaseDelay
+	webhookMaxAttempts, webhookRetryBaseDelay = 3, time.Minute
+	t.Cleanup(func() { webhookMaxAttempts, webhookRetryBaseDelay = maxAttempts, baseDelay })
+
+	newJob := func(url, secret string) webhookJob {
+		return webhookJob{
+			webhook: models.Webhook{ID: uuid.New(), URL: url, Secret: secret},
+			event:   models.Event{ID: uuid.New(), Type: models.EventApplicationSubmitted},
+			payload: []byte(`{"hello":"world"}`),
+			attempt: 1,
+		}
+	}
+
+	t.Run("retries with backoff until delivered", func(t *testing.T) {
+		receiver := &webhookReceiver{t: t, secret: "s3cret", failures: 2}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		job := newJob(server.URL, receiver.secret)
+		wantDelays := []time.Duration{time.Minute, 2 * time.Minute}
+		for _, wantDelay := range wantDelays {
+			failedBefore := time.Now()
+			delivery := attemptWebhook(context.Background(), job, true)
+			if delivery.Success || delivery.StatusCode == nil || *delivery.StatusCode != http.StatusServiceUnavailable {
+				t.Fatalf("attempt %d: success = %v, status = %v, want a failed 503", job.attempt, delivery.Success, delivery.StatusCode)
+			}
+			if delivery.NextAttemptAt == nil {
+				t.Fatalf("attempt %d: no retry scheduled", job.attempt)
+			}
+			if delay := delivery.NextAttemptAt.Sub(failedBefore); delay < wantDelay || delay > wantDelay+time.Minute/2 {
+				t.Errorf("attempt %d: retry after %v, want %v", job.attempt, delay, wantDelay)
+			}
+			job.attempt++
+		}
+
+		delivery := attemptWebhook(context.Background(), job, true)
+		if !delivery.Success || delivery.NextAttemptAt != nil || delivery.Error != nil {
+			t.Fatalf("attempt %d: success = %v, next attempt = %v, error = %v, want delivered", job.attempt, delivery.Success, delivery.NextAttemptAt, delivery.Error)
+		}
+		if got := receiver.seen(); len(got) != 3 || got[0] != "1" || got[1] != "2" || got[2] != "3" {
+			t.Errorf("X-Webhook-Attempt headers = %v, want [1 2 3]", got)
+		}
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		receiver := &webhookReceiver{t: t, secret: "another", failures: 10}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		job := newJob(server.URL, receiver.secret)
+		job.attempt = webhookMaxAttempts
+		if delivery := attemptWebhook(context.Background(), job, true); delivery.Success || delivery.NextAttemptAt != nil {
+			t.Fatalf("success = %v, next attempt = %v, want a failure without retry", delivery.Success, delivery.NextAttemptAt)
+		}
+	})
+
+	t.Run("does not retry test deliveries", func(t *testing.T) {
+		receiver := &webhookReceiver{t: t, secret: "test", failures: 1}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		if delivery := attemptWebhook(context.Background(), newJob(server.URL, receiver.secret), false); delivery.NextAttemptAt != nil {
+			t.Fatalf("next attempt = %v, want none", delivery.NextAttemptAt)
+		}
+		if got := receiver.seen(); len(got) != 1 || got[0] != "1" {
+			t.Errorf("X-Webhook-Attempt headers = %v, want [1]", got)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// TTLCache is a small in-memory cache whose entries expire after a given duration
+type TTLCache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]ttlCacheEntry[T]
+}
+
+type ttlCacheEntry[T any] struct {
+	value     T
+	expiresAt time.Time
+}
+
+// NewTTLCache creates an empty cache
+func NewTTLCache[T any]() *TTLCache[T] {
+	return &TTLCache[T]{
+		entries: make(map[string]ttlCacheEntry[T]),
+	}
+}
+
+// Get returns the cached value for key and when it expires, if present and not expired
+func (c *TTLCache[T]) Get(key string) (T, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		var zero T
+		return zero, time.Time{}, false
+	}
+	return entry.value, entry.expiresAt, true
+}
+
+// Set stores value under key for ttl and returns when it expires
+func (c *TTLCache[T]) Set(key string, value T, ttl time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	c.entries[key] = ttlCacheEntry[T]{value: value, expiresAt: expiresAt}
+	return expiresAt
+}
@@ -96,6 +96,77 @@ func GetPasswordResetSuccessTemplate() EmailTemplate {
 	}
 }
 
+// GetWithdrawalConfirmationTemplate returns the template confirming an application withdrawal
+func GetWithdrawalConfirmationTemplate(department string, reinstateUntil time.Time) EmailTemplate {
+	subject := GetEnvWithDefault(
+		"EMAIL_WITHDRAWAL_SUBJECT",
+		"IEEE Computer Society VITC - Application Withdrawn",
+	)
+
+	bodyTemplate := GetEnvWithDefault(
+		"EMAIL_WITHDRAWAL_BODY",
+		"Your application for the <strong>{{.DEPARTMENT}}</strong> department has been withdrawn. Changed your mind? You can reinstate it until {{.DEADLINE}}.",
+	)
+
+	// Replace placeholders
+	body := strings.ReplaceAll(bodyTemplate, "{{.DEPARTMENT}}", department)
+	body = strings.ReplaceAll(body, "{{.DEADLINE}}", reinstateUntil.UTC().Format("02 Jan 2006 15:04 MST"))
+
+	return EmailTemplate{
+		Subject: subject,
+		Body:    body,
+	}
+}
+
+// GetApplicationStatusTemplate returns the template telling an applicant their application status changed
+func GetApplicationStatusTemplate(department string) EmailTemplate {
+	subject := GetEnvWithDefault(
+		"EMAIL_STATUS_SUBJECT",
+		"IEEE Computer Society VITC - Application Update",
+	)
+
+	bodyTemplate := GetEnvWithDefault(
+		"EMAIL_STATUS_BODY",
+		"There is an update on your application for the <strong>{{.DEPARTMENT}}</strong> department. Log in to the recruitment portal to see it.",
+	)
+
+	// Replace placeholders
+	body := strings.ReplaceAll(bodyTemplate, "{{.DEPARTMENT}}", department)
+
+	return EmailTemplate{
+		Subject: subject,
+		Body:    body,
+	}
+}
+
+// GetWaitlistPromotionTemplate returns the template offering a freed seat to a waitlisted applicant.
+// deadline is nil when the offer stays open.
+func GetWaitlistPromotionTemplate(department string, deadline *time.Time) EmailTemplate {
+	subject := GetEnvWithDefault(
+		"EMAIL_WAITLIST_PROMOTION_SUBJECT",
+		"IEEE Computer Society VITC - A Place Has Opened Up",
+	)
+
+	bodyTemplate := GetEnvWithDefault(
+		"EMAIL_WAITLIST_PROMOTION_BODY",
+		"A place has opened up in the <strong>{{.DEPARTMENT}}</strong> department and it is being offered to you from the waitlist. Log in to the recruitment portal to accept or decline it{{.DEADLINE}}.",
+	)
+
+	respondBy := ""
+	if deadline != nil {
+		respondBy = " by " + deadline.UTC().Format("02 Jan 2006 15:04 MST")
+	}
+
+	// Replace placeholders
+	body := strings.ReplaceAll(bodyTemplate, "{{.DEPARTMENT}}", department)
+	body = strings.ReplaceAll(body, "{{.DEADLINE}}", respondBy)
+
+	return EmailTemplate{
+		Subject: subject,
+		Body:    body,
+	}
+}
+
 // formatDuration converts time.Duration to a human-readable string
 func formatDuration(d time.Duration) string {
 	if d >= time.Hour {
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// ShingleSet is the set of hashed word shingles of a text
+type ShingleSet map[uint64]struct{}
+
+// Shingles normalises text (lowercase, letters and digits only) and hashes every run of k consecutive words.
+// Texts shorter than k words produce a single shingle of all their words.
+func Shingles(text string, k int) ShingleSet {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	set := ShingleSet{}
+	if len(words) == 0 {
+		return set
+	}
+	if len(words) < k {
+		k = len(words)
+	}
+	for i := 0; i+k <= len(words); i++ {
+		h := fnv.New64a()
+		h.Write([]byte(strings.Join(words[i:i+k], " ")))
+		set[h.Sum64()] = struct{}{}
+	}
+	return set
+}
+
+// Jaccard returns the exact Jaccard similarity |A ∩ B| / |A ∪ B| of two shingle sets
+func Jaccard(a, b ShingleSet) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	shared := 0
+	for s := range a {
+		if _, ok := b[s]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// MinHasher computes fixed-length MinHash signatures whose agreement estimates Jaccard similarity
+type MinHasher struct {
+	a, b []uint64
+}
+
+// NewMinHasher creates a MinHasher with n deterministic hash functions
+func NewMinHasher(n int) *MinHasher {
+	m := &MinHasher{a: make([]uint64, n), b: make([]uint64, n)}
+	// SplitMix64 seeds keep signatures stable between runs
+	seed := uint64(0x9E3779B97F4A7C15)
+	next := func() uint64 {
+		seed += 0x9E3779B97F4A7C15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+		z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+		return z ^ (z >> 31)
+	}
+	for i := range n {
+		m.a[i] = next() | 1
+		m.b[i] = next()
+	}
+	return m
+}
+
+// Signature returns the MinHash signature of a shingle set
+func (m *MinHasher) Signature(set ShingleSet) []uint64 {
+	sig := make([]uint64, len(m.a))
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+	for s := range set {
+		for i := range sig {
+			if h := m.a[i]*s + m.b[i]; h < sig[i] {
+				sig[i] = h
+			}
+		}
+	}
+	return sig
+}
+
+// Bands splits a signature into LSH band keys; signatures sharing any band key are similarity candidates
+func Bands(sig []uint64, rows int) []uint64 {
+	bands := make([]uint64, 0, len(sig)/rows)
+	for start := 0; start+rows <= len(sig); start += rows {
+		h := fnv.New64a()
+		var buf [8]byte
+		// Include the band index so equal values in different bands don't collide
+		h.Write([]byte{byte(start / rows)})
+		for _, v := range sig[start : start+rows] {
+			for j := range buf {
+				buf[j] = byte(v >> (8 * j))
+			}
+			h.Write(buf[:])
+		}
+		bands = append(bands, h.Sum64())
+	}
+	return bands
+}
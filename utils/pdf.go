@@ -0,0 +1,308 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// PDF page geometry in points (A4)
+const (
+	pdfPageWidth   = 595.0
+	pdfPageHeight  = 842.0
+	pdfMargin      = 50.0
+	pdfFooterSpace = 30.0
+	pdfMinWrap     = 100.0 // Narrowest column text is wrapped to
+)
+
+// pdfFont identifies one of the two standard fonts used by PDFDocument
+type pdfFont int
+
+const (
+	pdfFontRegular pdfFont = iota
+	pdfFontBold
+)
+
+// PDFDocument builds a simple paginated text PDF using the standard Helvetica fonts,
+// so no font files need to be embedded. Text outside WinAnsi (Latin-1) is replaced with '?'.
+type PDFDocument struct {
+	title   string
+	pages   []*bytes.Buffer
+	current *bytes.Buffer
+	y       float64
+}
+
+// NewPDFDocument creates an empty document with a first page
+func NewPDFDocument(title string) *PDFDocument {
+	d := &PDFDocument{title: title}
+	d.newPage()
+	return d
+}
+
+// Heading writes a large bold line
+func (d *PDFDocument) Heading(text string) {
+	d.writeWrapped(text, pdfFontBold, 16, 0)
+	d.Spacer(6)
+}
+
+// Subheading writes a bold section title
+func (d *PDFDocument) Subheading(text string) {
+	d.Spacer(6)
+	d.writeWrapped(text, pdfFontBold, 12, 0)
+	d.Spacer(2)
+}
+
+// Paragraph writes wrapped body text, preserving explicit line breaks
+func (d *PDFDocument) Paragraph(text string) {
+	d.writeWrapped(text, pdfFontRegular, 10, 0)
+	d.Spacer(4)
+}
+
+// IndentedParagraph writes wrapped body text shifted right by indent points
+func (d *PDFDocument) IndentedParagraph(text string, indent float64) {
+	d.writeWrapped(text, pdfFontRegular, 10, indent)
+	d.Spacer(4)
+}
+
+// KeyValue writes a "key: value" line with a bold key. A key too wide to leave room for the value
+// beside it is written on its own line, with the value below.
+func (d *PDFDocument) KeyValue(key, value string) {
+	label := key + ": "
+	labelWidth := pdfTextWidth(label, pdfFontBold, 10)
+	if pdfPageWidth-2*pdfMargin-labelWidth < pdfMinWrap {
+		d.writeWrapped(label, pdfFontBold, 10, 0)
+		d.writeWrapped(value, pdfFontRegular, 10, 0)
+		return
+	}
+
+	d.ensureSpace(14)
+	d.text(pdfMargin, d.y, label, pdfFontBold, 10)
+
+	lines := pdfWrap(value, pdfFontRegular, 10, pdfPageWidth-2*pdfMargin-labelWidth)
+	for i, line := range lines {
+		if i > 0 {
+			d.ensureSpace(14)
+		}
+		d.text(pdfMargin+labelWidth, d.y, line, pdfFontRegular, 10)
+		d.y -= 14
+	}
+}
+
+// Spacer adds vertical whitespace
+func (d *PDFDocument) Spacer(height float64) {
+	d.y -= height
+}
+
+// PageBreak starts a new page
+func (d *PDFDocument) PageBreak() {
+	d.newPage()
+}
+
+// WriteTo serialises the document, adding "Page X of N" footers
+func (d *PDFDocument) WriteTo(w io.Writer) (int64, error) {
+	var out bytes.Buffer
+	offsets := []int{}
+
+	writeObject := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	pageCount := len(d.pages)
+	// Object layout: 1 catalog, 2 pages, 3-4 fonts, 5 info, then a page and content object per page
+	kids := make([]string, pageCount)
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+i*2)
+	}
+
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), pageCount))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	writeObject(fmt.Sprintf("<< /Title (%s) /Producer (recruitment-backend) >>", pdfEscape(d.title)))
+
+	for i, page := range d.pages {
+		footer := fmt.Sprintf("Page %d of %d", i+1, pageCount)
+		var content bytes.Buffer
+		content.Write(page.Bytes())
+		pdfTextOp(&content, pdfPageWidth-pdfMargin-pdfTextWidth(footer, pdfFontRegular, 8), pdfMargin/2, footer, pdfFontRegular, 8)
+
+		writeObject(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 7+i*2,
+		))
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.Bytes()))
+	}
+
+	xrefOffset := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xrefOffset)
+
+	return out.WriteTo(w)
+}
+
+// newPage starts a fresh page with the cursor at the top margin
+func (d *PDFDocument) newPage() {
+	d.current = &bytes.Buffer{}
+	d.pages = append(d.pages, d.current)
+	d.y = pdfPageHeight - pdfMargin
+}
+
+// ensureSpace breaks the page when fewer than height points remain above the footer
+func (d *PDFDocument) ensureSpace(height float64) {
+	if d.y-height < pdfMargin+pdfFooterSpace {
+		d.newPage()
+	}
+}
+
+// writeWrapped writes text wrapped to the page width, breaking pages as needed
+func (d *PDFDocument) writeWrapped(text string, font pdfFont, size, indent float64) {
+	lineHeight := size * 1.4
+	for _, line := range pdfWrap(text, font, size, pdfPageWidth-2*pdfMargin-indent) {
+		d.ensureSpace(lineHeight)
+		d.text(pdfMargin+indent, d.y, line, font, size)
+		d.y -= lineHeight
+	}
+}
+
+// text writes a single line at an absolute position
+func (d *PDFDocument) text(x, y float64, line string, font pdfFont, size float64) {
+	pdfTextOp(d.current, x, y-size, line, font, size)
+}
+
+// pdfTextOp emits the content stream operators for a single line of text
+func pdfTextOp(buf *bytes.Buffer, x, y float64, line string, font pdfFont, size float64) {
+	fontName := "F1"
+	if font == pdfFontBold {
+		fontName = "F2"
+	}
+	fmt.Fprintf(buf, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", fontName, size, x, y, pdfEscape(line))
+}
+
+// pdfWrap splits text into lines that fit within maxWidth points, or pdfMinWrap if that is narrower
+func pdfWrap(text string, font pdfFont, size, maxWidth float64) []string {
+	maxWidth = max(maxWidth, pdfMinWrap)
+	var lines []string
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		line := ""
+		for _, word := range words {
+			// Hard-break words that are wider than a whole line (e.g. long URLs)
+			for word != "" && pdfTextWidth(word, font, size) > maxWidth {
+				if line != "" {
+					lines = append(lines, line)
+					line = ""
+				}
+				runes := []rune(word)
+				cut := len(runes)
+				for cut > 1 && pdfTextWidth(string(runes[:cut]), font, size) > maxWidth {
+					cut--
+				}
+				lines = append(lines, string(runes[:cut]))
+				word = string(runes[cut:])
+			}
+			if word == "" {
+				continue
+			}
+
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if pdfTextWidth(candidate, font, size) <= maxWidth {
+				line = candidate
+				continue
+			}
+			lines = append(lines, line)
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// pdfTextWidth returns the rendered width of text in points
+func pdfTextWidth(text string, font pdfFont, size float64) float64 {
+	widths := helveticaWidths
+	if font == pdfFontBold {
+		widths = helveticaBoldWidths
+	}
+
+	total := 0
+	for _, r := range text {
+		if r >= 32 && r <= 126 {
+			total += widths[r-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// pdfEscape converts text to WinAnsi bytes and escapes PDF string delimiters
+func pdfEscape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		c, ok := winAnsiByte(r)
+		if !ok {
+			c = '?'
+		}
+		switch c {
+		case '\\', '(', ')':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// winAnsiSpecials maps common typographic characters to their WinAnsiEncoding codes
+var winAnsiSpecials = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '•': 0x95, '–': 0x96, '—': 0x97,
+	'‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '™': 0x99,
+}
+
+// winAnsiByte returns the WinAnsiEncoding byte for a rune, if it has one
+func winAnsiByte(r rune) (byte, bool) {
+	if r == '\t' {
+		return ' ', true
+	}
+	if (r >= 32 && r <= 126) || (r >= 0xA0 && r <= 0xFF) {
+		return byte(r), true
+	}
+	c, ok := winAnsiSpecials[r]
+	return c, ok
+}
+
+// Standard Helvetica glyph widths (1/1000 em) for ASCII 32-126
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// Standard Helvetica-Bold glyph widths (1/1000 em) for ASCII 32-126
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}